@@ -14,11 +14,94 @@ type PackageVersion struct {
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
+	// Version defines the version of the firmware package. Besides exact
+	// version it might be a constraint expression like ">=2.8.0 <3.0.0",
+	// "~2.8" or "latest", which is resolved against available versions.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+}
+
+// PackageSource defines where the desired package version comes from.
+type PackageSource string
+
+const (
+	// PackageSourceMachine means the version is defined in machine's spec.
+	PackageSourceMachine PackageSource = "Machine"
+	// PackageSourceMachineGroup means the version is defined by machine group.
+	PackageSourceMachineGroup PackageSource = "MachineGroup"
+)
+
+// DesiredPackageVersion defines the effective package version along with
+// its origin.
+type DesiredPackageVersion struct {
+	// Name defines the name of the firmware package.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
 	// Version defines the version of the firmware package.
 	// +kubebuilder:validation:Required
 	Version string `json:"version"`
+
+	// Source defines where the version comes from.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Machine;MachineGroup
+	Source PackageSource `json:"source"`
+
+	// MachineGroup defines the name of machine group the version comes from.
+	// +kubebuilder:validation:Optional
+	MachineGroup string `json:"machineGroup,omitempty"`
+
+	// Constraint defines the version constraint the version is resolved from.
+	// +kubebuilder:validation:Optional
+	Constraint string `json:"constraint,omitempty"`
 }
 
+const (
+	// ConditionScanned indicates that the last scan succeeded.
+	ConditionScanned = "Scanned"
+	// ConditionScanFailed indicates that the last scan failed.
+	ConditionScanFailed = "ScanFailed"
+	// ConditionServiceReachable indicates whether the last request to
+	// lifecycle-service succeeded.
+	ConditionServiceReachable = "ServiceReachable"
+)
+
+const (
+	// EventReasonOnboarded is the reason of the event reporting the object
+	// created by onboarding.
+	EventReasonOnboarded = "Onboarded"
+	// EventReasonScanScheduled is the reason of the event reporting the scan
+	// job accepted by the scheduler.
+	EventReasonScanScheduled = "ScanScheduled"
+	// EventReasonScanStarted is the reason of the event reporting the scan
+	// job started.
+	EventReasonScanStarted = "ScanStarted"
+	// EventReasonScanCompleted is the reason of the event reporting the
+	// successful scan.
+	EventReasonScanCompleted = "ScanCompleted"
+	// EventReasonScanFailed is the reason of the event reporting the failed
+	// scan.
+	EventReasonScanFailed = "ScanFailed"
+	// EventReasonInstallScheduled is the reason of the event reporting the
+	// install job accepted by the scheduler.
+	EventReasonInstallScheduled = "InstallScheduled"
+	// EventReasonInstallStarted is the reason of the event reporting the
+	// install job started.
+	EventReasonInstallStarted = "InstallStarted"
+	// EventReasonInstallCompleted is the reason of the event reporting the
+	// successful installation.
+	EventReasonInstallCompleted = "InstallCompleted"
+	// EventReasonInstallFailed is the reason of the event reporting the
+	// failed installation.
+	EventReasonInstallFailed = "InstallFailed"
+	// EventReasonQueueFull is the reason of the event reporting the job
+	// rejected, since the queue of the scheduler is full.
+	EventReasonQueueFull = "QueueFull"
+	// EventReasonRequestFailed is the reason of the event reporting the
+	// failed request to lifecycle-service.
+	EventReasonRequestFailed = "RequestFailed"
+)
+
 type ScanResult string
 
 const (
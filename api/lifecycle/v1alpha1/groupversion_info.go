@@ -24,4 +24,10 @@ var (
 func init() {
 	SchemeBuilder.Register(&Machine{}, &MachineList{})
 	SchemeBuilder.Register(&MachineType{}, &MachineTypeList{})
+	SchemeBuilder.Register(&MachineHistory{}, &MachineHistoryList{})
+}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource.
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
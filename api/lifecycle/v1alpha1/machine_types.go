@@ -10,6 +10,71 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// MachineConditionGroupConflict indicates that several machine groups
+	// with the same priority match the machine.
+	MachineConditionGroupConflict = "GroupConflict"
+	// MachineConditionVersionUnresolved indicates that version constraints of
+	// some desired packages match none of available versions.
+	MachineConditionVersionUnresolved = "VersionUnresolved"
+	// MachineConditionDowngradeBlocked indicates that some desired packages
+	// are not installed, since their versions are lower than installed ones
+	// and downgrade policy does not allow it.
+	MachineConditionDowngradeBlocked = "DowngradeBlocked"
+	// MachineConditionInstallFailed indicates that the last installation of
+	// packages failed.
+	MachineConditionInstallFailed = "InstallFailed"
+	// MachineConditionWaitingForMaintenanceWindow indicates that pending
+	// packages are not installed, since maintenance windows applying to the
+	// machine are closed.
+	MachineConditionWaitingForMaintenanceWindow = "WaitingForMaintenanceWindow"
+	// MachineConditionWaitingForApproval indicates that pending packages are
+	// not installed, since the installation is either not approved yet or
+	// rejected.
+	MachineConditionWaitingForApproval = "WaitingForApproval"
+	// MachineConditionPaused indicates that lifecycle operations of the
+	// machine are paused either by the machine or by its machine type.
+	MachineConditionPaused = "Paused"
+	// MachineConditionFirmwareDrift indicates that the scan found versions of
+	// packages changed out of band, e.g. flashed through BMC. Changes of
+	// packages without desired version are reported as an alert only.
+	MachineConditionFirmwareDrift = "FirmwareDrift"
+	// MachineConditionUpToDate indicates that desired packages are installed.
+	MachineConditionUpToDate = "UpToDate"
+	// MachineConditionInstalling indicates that installation of pending
+	// packages is requested or in progress.
+	MachineConditionInstalling = "Installing"
+)
+
+const (
+	// AllowDowngradeAnnotation allows downgrade of machine's packages if the
+	// downgrade policy is AllowWithAnnotation. Annotation value must be "true".
+	AllowDowngradeAnnotation = "lifecycle.ironcore.dev/allow-downgrade"
+	// RolloutRevisionAnnotation is set by the controller on machines admitted
+	// to install packages of the machine group rollout revision.
+	RolloutRevisionAnnotation = "lifecycle.ironcore.dev/rollout-revision"
+	// ApprovedByAnnotation approves the pending installation of the machine
+	// on behalf of the user in annotation value. The controller records the
+	// decision and removes the annotation.
+	ApprovedByAnnotation = "lifecycle.ironcore.dev/approved-by"
+	// RejectedByAnnotation rejects the pending installation of the machine
+	// on behalf of the user in annotation value. The controller records the
+	// decision and removes the annotation.
+	RejectedByAnnotation = "lifecycle.ironcore.dev/rejected-by"
+	// ApprovalReasonAnnotation optionally explains the decision made with
+	// ApprovedByAnnotation or RejectedByAnnotation.
+	ApprovalReasonAnnotation = "lifecycle.ironcore.dev/approval-reason"
+	// PausedAnnotation pauses lifecycle operations of the annotated Machine
+	// or of all machines of the annotated MachineType. Controllers issue no
+	// new scans and installations while annotation value is "true".
+	PausedAnnotation = "lifecycle.ironcore.dev/paused"
+)
+
+// IsPaused reports whether the object is annotated with PausedAnnotation.
+func IsPaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[PausedAnnotation] == "true"
+}
+
 // MachineSpec defines the desired state of Machine.
 type MachineSpec struct {
 	// MachineTypeRef contain reference to MachineType object.
@@ -44,6 +109,11 @@ type MachineStatus struct {
 	// +kubebuilder:validation:Optional
 	InstalledPackages []PackageVersion `json:"installedPackages"`
 
+	// DesiredPackages reflects the effective versions of firmware packages
+	// resolved from machine's spec and defaults of the machine group.
+	// +kubebuilder:validation:Optional
+	DesiredPackages []DesiredPackageVersion `json:"desiredPackages"`
+
 	// Message contains verbose message explaining current state
 	// +kubebuilder:validation:Optional
 	Message string `json:"message"`
@@ -51,6 +121,138 @@ type MachineStatus struct {
 	// Conditions reflects Machine conditions and their state
 	// +kubebuilder:validation:Optional
 	Conditions []metav1.Condition `json:"conditions"`
+
+	// Approval reflects the approval of the pending installation, if the
+	// machine group requires it.
+	// +kubebuilder:validation:Optional
+	Approval *InstallApproval `json:"approval,omitempty"`
+
+	// ApprovalHistory reflects the latest decisions on installations of the
+	// machine, the most recent one is the last.
+	// +kubebuilder:validation:Optional
+	ApprovalHistory []InstallApproval `json:"approvalHistory,omitempty"`
+
+	// PackageStatuses reflects the progress of installation of packages,
+	// one entry per package.
+	// +kubebuilder:validation:Optional
+	PackageStatuses []PackageInstallStatus `json:"packageStatuses,omitempty"`
+}
+
+// PackageInstallState is the state of installation of the package.
+// +kubebuilder:validation:Enum=Pending;Downloading;Installing;AwaitingReboot;Succeeded;Failed
+type PackageInstallState string
+
+const (
+	// PackageInstallStatePending means the package waits for installation.
+	PackageInstallStatePending PackageInstallState = "Pending"
+	// PackageInstallStateDownloading means the package is being downloaded.
+	PackageInstallStateDownloading PackageInstallState = "Downloading"
+	// PackageInstallStateInstalling means the package is being installed.
+	PackageInstallStateInstalling PackageInstallState = "Installing"
+	// PackageInstallStateAwaitingReboot means the package is installed, but
+	// takes effect after the machine reboots.
+	PackageInstallStateAwaitingReboot PackageInstallState = "AwaitingReboot"
+	// PackageInstallStateSucceeded means the package is installed.
+	PackageInstallStateSucceeded PackageInstallState = "Succeeded"
+	// PackageInstallStateFailed means the last attempt to install the package
+	// failed.
+	PackageInstallStateFailed PackageInstallState = "Failed"
+)
+
+// IsActive returns true if the state belongs to the attempt in progress.
+func (s PackageInstallState) IsActive() bool {
+	switch s {
+	case PackageInstallStateDownloading, PackageInstallStateInstalling, PackageInstallStateAwaitingReboot:
+		return true
+	}
+	return false
+}
+
+// PackageInstallStatus reflects the progress of installation of the package
+// version.
+type PackageInstallStatus struct {
+	// Name reflects the name of the package.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Version reflects the version of the package being installed.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+
+	// State reflects the state of installation.
+	// +kubebuilder:validation:Required
+	State PackageInstallState `json:"state"`
+
+	// Attempts reflects the number of attempts to install the version.
+	// +kubebuilder:validation:Optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// LastError reflects the error of the last failed attempt.
+	// +kubebuilder:validation:Optional
+	LastError string `json:"lastError,omitempty"`
+
+	// JobID reflects the job which performed the last attempt.
+	// +kubebuilder:validation:Optional
+	JobID string `json:"jobId,omitempty"`
+
+	// StartTime reflects when the last attempt started.
+	// +kubebuilder:validation:Optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime reflects when the last attempt either succeeded or
+	// failed.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// LastTransitionTime reflects when the state changed last time.
+	// +kubebuilder:validation:Optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ApprovalState is the state of the install approval.
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected
+type ApprovalState string
+
+const (
+	// ApprovalStatePending means the installation waits for the decision.
+	ApprovalStatePending ApprovalState = "Pending"
+	// ApprovalStateApproved means the installation might proceed.
+	ApprovalStateApproved ApprovalState = "Approved"
+	// ApprovalStateRejected means the installation is not performed until
+	// pending packages change.
+	ApprovalStateRejected ApprovalState = "Rejected"
+)
+
+// InstallApproval defines the request to approve installation of pending
+// packages and the decision made on it.
+type InstallApproval struct {
+	// Revision identifies pending packages the approval applies to.
+	// +kubebuilder:validation:Required
+	Revision string `json:"revision"`
+
+	// Packages reflects the package versions pending installation.
+	// +kubebuilder:validation:Optional
+	Packages []PackageVersion `json:"packages,omitempty"`
+
+	// State reflects whether the installation is approved.
+	// +kubebuilder:validation:Required
+	State ApprovalState `json:"state"`
+
+	// RequestTime reflects when the approval was requested.
+	// +kubebuilder:validation:Optional
+	RequestTime metav1.Time `json:"requestTime,omitempty"`
+
+	// User reflects who approved or rejected the installation.
+	// +kubebuilder:validation:Optional
+	User string `json:"user,omitempty"`
+
+	// DecisionTime reflects when the installation was approved or rejected.
+	// +kubebuilder:validation:Optional
+	DecisionTime *metav1.Time `json:"decisionTime,omitempty"`
+
+	// Reason explains the decision.
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty"`
 }
 
 // +kubebuilder:object:root=true
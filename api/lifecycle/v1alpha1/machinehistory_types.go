@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FirmwareChangeSource defines how the change of firmware was found.
+// +kubebuilder:validation:Enum=Scan;Install
+type FirmwareChangeSource string
+
+const (
+	// FirmwareChangeSourceScan means the change was observed by the scan.
+	FirmwareChangeSourceScan FirmwareChangeSource = "Scan"
+	// FirmwareChangeSourceInstall means the change was made by installation
+	// of packages.
+	FirmwareChangeSourceInstall FirmwareChangeSource = "Install"
+)
+
+// FirmwareChange reflects the change of installed version of the package.
+type FirmwareChange struct {
+	// Time reflects when the change was recorded.
+	// +kubebuilder:validation:Required
+	Time metav1.Time `json:"time"`
+
+	// Package reflects the name of the package.
+	// +kubebuilder:validation:Required
+	Package string `json:"package"`
+
+	// OldVersion reflects the version installed before the change, empty if
+	// the package was not installed.
+	// +kubebuilder:validation:Optional
+	OldVersion string `json:"oldVersion,omitempty"`
+
+	// NewVersion reflects the version installed after the change, empty if
+	// the package was removed.
+	// +kubebuilder:validation:Optional
+	NewVersion string `json:"newVersion,omitempty"`
+
+	// Source reflects whether the change was observed by the scan or made by
+	// installation.
+	// +kubebuilder:validation:Required
+	Source FirmwareChangeSource `json:"source"`
+
+	// JobID reflects the job which reported the change.
+	// +kubebuilder:validation:Optional
+	JobID string `json:"jobId,omitempty"`
+
+	// Initiator reflects the user who requested the job, if known.
+	// +kubebuilder:validation:Optional
+	Initiator string `json:"initiator,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+
+// MachineHistory is the Schema for the machinehistories API. It records changes
+// of firmware of the Machine with the same name.
+type MachineHistory struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Changes reflects the latest changes of installed firmware, the most
+	// recent one is the last.
+	// +kubebuilder:validation:Optional
+	Changes []FirmwareChange `json:"changes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineHistoryList contains a list of MachineHistory.
+type MachineHistoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachineHistory `json:"items"`
+}
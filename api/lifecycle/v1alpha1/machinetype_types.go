@@ -7,6 +7,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MachineTypeSpec defines the desired state of MachineType.
@@ -26,8 +27,62 @@ type MachineTypeSpec struct {
 	// MachineGroups defines list of MachineGroup
 	// +kubebuilder:validation:Optional
 	MachineGroups []MachineGroup `json:"machineGroups"`
+
+	// DowngradePolicy defines whether package versions lower than installed
+	// ones might be installed. Defaults to Deny.
+	// +kubebuilder:validation:Optional
+	DowngradePolicy DowngradePolicy `json:"downgradePolicy,omitempty"`
+
+	// MaintenanceWindows defines periods of time, when packages might be
+	// installed. Windows apply to machines of groups referring to them and to
+	// machines matching their selectors. Machines, which no window applies to,
+	// might be installed at any time.
+	// +kubebuilder:validation:Optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow defines recurring period of time, when disruptive work
+// like installation of packages is allowed.
+type MaintenanceWindow struct {
+	// Name defines maintenance window name.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Schedule defines start of the window in cron format, e.g. "0 22 * * 1-5"
+	// starts the window at 22:00 on weekdays.
+	// +kubebuilder:validation:Required
+	Schedule string `json:"schedule"`
+
+	// Duration defines how long the window lasts.
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone defines the time zone of the schedule, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// MachineSelector selects machines the window applies to regardless of
+	// their machine group.
+	// +kubebuilder:validation:Optional
+	MachineSelector *metav1.LabelSelector `json:"machineSelector,omitempty"`
 }
 
+// DowngradePolicy defines whether package versions lower than installed ones
+// might be installed.
+// +kubebuilder:validation:Enum=Deny;AllowWithAnnotation;Allow
+type DowngradePolicy string
+
+const (
+	// DowngradePolicyDeny denies downgrade of packages.
+	DowngradePolicyDeny DowngradePolicy = "Deny"
+	// DowngradePolicyAllowWithAnnotation allows downgrade of packages on
+	// machines annotated with AllowDowngradeAnnotation.
+	DowngradePolicyAllowWithAnnotation DowngradePolicy = "AllowWithAnnotation"
+	// DowngradePolicyAllow allows downgrade of packages.
+	DowngradePolicyAllow DowngradePolicy = "Allow"
+)
+
 // MachineGroup defines group of Machine objects filtered by label selector
 // and a list of firmware packages versions which should be installed by default.
 type MachineGroup struct {
@@ -42,6 +97,94 @@ type MachineGroup struct {
 	// Packages defines default firmware package versions for the group of Machine objects.
 	// +kubebuilder:validation:Required
 	Packages []PackageVersion `json:"packages"`
+
+	// Priority defines precedence of the group if machine matches several
+	// groups. Group with higher value takes precedence.
+	// +kubebuilder:validation:Optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// DowngradePolicy overrides downgrade policy of the machine type for
+	// machines of the group.
+	// +kubebuilder:validation:Optional
+	DowngradePolicy DowngradePolicy `json:"downgradePolicy,omitempty"`
+
+	// Rollout defines how changes of group packages are rolled out to machines
+	// of the group. If not set, all machines install changes at once. Applies
+	// to named groups only.
+	// +kubebuilder:validation:Optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+
+	// MaintenanceWindows defines names of maintenance windows of the machine
+	// type, which apply to machines of the group.
+	// +kubebuilder:validation:Optional
+	MaintenanceWindows []string `json:"maintenanceWindows,omitempty"`
+
+	// Approval defines whether installation of packages on machines of the
+	// group must be approved. Defaults to None.
+	// +kubebuilder:validation:Optional
+	Approval ApprovalMode `json:"approval,omitempty"`
+
+	// DriftPolicy defines whether machines of the group, which firmware was
+	// changed out of band, are re-converged to the desired state or only
+	// reported. Defaults to Reconverge.
+	// +kubebuilder:validation:Optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+// ApprovalMode defines whether installation of packages must be approved.
+// +kubebuilder:validation:Enum=None;Required
+type ApprovalMode string
+
+const (
+	// ApprovalModeNone means packages are installed without approval.
+	ApprovalModeNone ApprovalMode = "None"
+	// ApprovalModeRequired means pending packages are installed once the
+	// installation is approved.
+	ApprovalModeRequired ApprovalMode = "Required"
+)
+
+// DriftPolicy defines how firmware drift of the machine is handled.
+// +kubebuilder:validation:Enum=Reconverge;Alert
+type DriftPolicy string
+
+const (
+	// DriftPolicyReconverge means drifted packages are installed again as any
+	// other pending packages.
+	DriftPolicyReconverge DriftPolicy = "Reconverge"
+	// DriftPolicyAlert means drift is only reported, pending packages are not
+	// installed until the drift is resolved.
+	DriftPolicyAlert DriftPolicy = "Alert"
+)
+
+// RolloutStrategy defines how changes of machine group packages are rolled
+// out. Machines are admitted to install changes in batches, the next batch is
+// started once the previous one is finished and the pause is over.
+type RolloutStrategy struct {
+	// MaxUnavailable defines the maximum number of machines installing
+	// packages at once, either absolute number or percentage of machines in
+	// the group. Defaults to 1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// CanaryBatchSize defines the number of machines in the first batch. If
+	// not set, the first batch is limited by MaxUnavailable.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	CanaryBatchSize int32 `json:"canaryBatchSize,omitempty"`
+
+	// PauseBetweenBatches defines the pause between the end of the batch and
+	// the start of the next one.
+	// +kubebuilder:validation:Optional
+	PauseBetweenBatches metav1.Duration `json:"pauseBetweenBatches,omitempty"`
+
+	// MaxFailurePercentage defines the percentage of failed installations
+	// among admitted machines, exceeding which halts the rollout. Defaults to
+	// 0, so the first failure halts the rollout.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxFailurePercentage int32 `json:"maxFailurePercentage,omitempty"`
 }
 
 // MachineTypeStatus defines the observed state of MachineType.
@@ -61,6 +204,82 @@ type MachineTypeStatus struct {
 	// Message contains verbose message explaining current state
 	// +kubebuilder:validation:Optional
 	Message string `json:"message"`
+
+	// Rollouts reflects the progress of rollouts of machine groups having
+	// rollout strategy.
+	// +kubebuilder:validation:Optional
+	Rollouts []MachineGroupRolloutStatus `json:"rollouts,omitempty"`
+
+	// Conditions reflects MachineType conditions and their state.
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// MachineTypeConditionPaused indicates that lifecycle operations of the
+	// machine type and its machines are paused.
+	MachineTypeConditionPaused = "Paused"
+)
+
+// RolloutPhase is the phase of machine group rollout.
+// +kubebuilder:validation:Enum=Progressing;Paused;Halted;Completed
+type RolloutPhase string
+
+const (
+	// RolloutPhaseProgressing means the batch of machines is being installed.
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused means the batch is finished and the next one waits
+	// for the pause between batches to pass.
+	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhaseHalted means the failure ratio exceeded the threshold and
+	// no more machines are admitted until group packages change.
+	RolloutPhaseHalted RolloutPhase = "Halted"
+	// RolloutPhaseCompleted means all machines of the group are up to date.
+	RolloutPhaseCompleted RolloutPhase = "Completed"
+)
+
+// MachineGroupRolloutStatus defines the progress of machine group rollout.
+type MachineGroupRolloutStatus struct {
+	// Name is the name of the machine group.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Revision identifies packages of the group being rolled out. Machines
+	// admitted to install them are annotated with the revision.
+	// +kubebuilder:validation:Required
+	Revision string `json:"revision"`
+
+	// Phase is the current phase of the rollout.
+	// +kubebuilder:validation:Optional
+	Phase RolloutPhase `json:"phase,omitempty"`
+
+	// Batch is the number of started batches.
+	// +kubebuilder:validation:Optional
+	Batch int32 `json:"batch"`
+
+	// Machines is the number of machines in the group.
+	// +kubebuilder:validation:Optional
+	Machines int32 `json:"machines"`
+
+	// Updated is the number of machines having group packages installed.
+	// +kubebuilder:validation:Optional
+	Updated int32 `json:"updated"`
+
+	// Installing is the number of admitted machines installing packages.
+	// +kubebuilder:validation:Optional
+	Installing int32 `json:"installing"`
+
+	// Failed is the number of admitted machines failed to install packages.
+	// +kubebuilder:validation:Optional
+	Failed int32 `json:"failed"`
+
+	// LastTransitionTime is the last time the phase changed.
+	// +kubebuilder:validation:Optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message explains the current phase.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
 }
 
 // AvailablePackageVersions defines a number of versions for concrete firmware package.
@@ -72,6 +291,23 @@ type AvailablePackageVersions struct {
 	// Versions reflects the list of discovered package versions available for installation.
 	// +kubebuilder:validation:Required
 	Versions []string `json:"versions"`
+
+	// Dependencies reflects packages, which must be installed before any
+	// version of the package, e.g. BMC firmware required by BIOS.
+	// +kubebuilder:validation:Optional
+	Dependencies []PackageDependency `json:"dependencies,omitempty"`
+}
+
+// PackageDependency defines the package required by another package.
+type PackageDependency struct {
+	// Name defines the name of the required package.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Version defines the required version either as exact version or as
+	// constraint expression, e.g. ">=1.5.0". Any version satisfies empty one.
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -28,6 +28,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.AvailablePackageVersions
   map:
     fields:
+    - name: dependencies
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageDependency
+          elementRelationship: atomic
     - name: name
       type:
         scalar: string
@@ -38,6 +44,83 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.DesiredPackageVersion
+  map:
+    fields:
+    - name: constraint
+      type:
+        scalar: string
+    - name: machineGroup
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: source
+      type:
+        scalar: string
+      default: ""
+    - name: version
+      type:
+        scalar: string
+      default: ""
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.FirmwareChange
+  map:
+    fields:
+    - name: initiator
+      type:
+        scalar: string
+    - name: jobId
+      type:
+        scalar: string
+    - name: newVersion
+      type:
+        scalar: string
+    - name: oldVersion
+      type:
+        scalar: string
+    - name: package
+      type:
+        scalar: string
+      default: ""
+    - name: source
+      type:
+        scalar: string
+      default: ""
+    - name: time
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+      default: {}
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.InstallApproval
+  map:
+    fields:
+    - name: decisionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: packages
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageVersion
+          elementRelationship: atomic
+    - name: reason
+      type:
+        scalar: string
+    - name: requestTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: revision
+      type:
+        scalar: string
+      default: ""
+    - name: state
+      type:
+        scalar: string
+      default: ""
+    - name: user
+      type:
+        scalar: string
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.Machine
   map:
     fields:
@@ -62,10 +145,25 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineGroup
   map:
     fields:
+    - name: approval
+      type:
+        scalar: string
+    - name: downgradePolicy
+      type:
+        scalar: string
+    - name: driftPolicy
+      type:
+        scalar: string
     - name: machineSelector
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
       default: {}
+    - name: maintenanceWindows
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
     - name: name
       type:
         scalar: string
@@ -76,6 +174,71 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageVersion
           elementRelationship: atomic
+    - name: priority
+      type:
+        scalar: numeric
+    - name: rollout
+      type:
+        namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.RolloutStrategy
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineGroupRolloutStatus
+  map:
+    fields:
+    - name: batch
+      type:
+        scalar: numeric
+      default: 0
+    - name: failed
+      type:
+        scalar: numeric
+      default: 0
+    - name: installing
+      type:
+        scalar: numeric
+      default: 0
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: machines
+      type:
+        scalar: numeric
+      default: 0
+    - name: message
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: phase
+      type:
+        scalar: string
+    - name: revision
+      type:
+        scalar: string
+      default: ""
+    - name: updated
+      type:
+        scalar: numeric
+      default: 0
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineHistory
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: changes
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.FirmwareChange
+          elementRelationship: atomic
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineSpec
   map:
     fields:
@@ -100,12 +263,27 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineStatus
   map:
     fields:
+    - name: approval
+      type:
+        namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.InstallApproval
+    - name: approvalHistory
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.InstallApproval
+          elementRelationship: atomic
     - name: conditions
       type:
         list:
           elementType:
             namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
           elementRelationship: atomic
+    - name: desiredPackages
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.DesiredPackageVersion
+          elementRelationship: atomic
     - name: installedPackages
       type:
         list:
@@ -124,6 +302,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: packageStatuses
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageInstallStatus
+          elementRelationship: atomic
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineType
   map:
     fields:
@@ -148,12 +332,21 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineTypeSpec
   map:
     fields:
+    - name: downgradePolicy
+      type:
+        scalar: string
     - name: machineGroups
       type:
         list:
           elementType:
             namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineGroup
           elementRelationship: atomic
+    - name: maintenanceWindows
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MaintenanceWindow
+          elementRelationship: atomic
     - name: manufacturer
       type:
         scalar: string
@@ -175,6 +368,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.AvailablePackageVersions
           elementRelationship: atomic
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: atomic
     - name: lastScanResult
       type:
         scalar: string
@@ -187,6 +386,76 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: rollouts
+      type:
+        list:
+          elementType:
+            namedType: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MachineGroupRolloutStatus
+          elementRelationship: atomic
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.MaintenanceWindow
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+      default: 0
+    - name: machineSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: schedule
+      type:
+        scalar: string
+      default: ""
+    - name: timeZone
+      type:
+        scalar: string
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageDependency
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: version
+      type:
+        scalar: string
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageInstallStatus
+  map:
+    fields:
+    - name: attempts
+      type:
+        scalar: numeric
+    - name: completionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: jobId
+      type:
+        scalar: string
+    - name: lastError
+      type:
+        scalar: string
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: startTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: state
+      type:
+        scalar: string
+      default: ""
+    - name: version
+      type:
+        scalar: string
+      default: ""
 - name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.PackageVersion
   map:
     fields:
@@ -198,6 +467,21 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.ironcore-dev.lifecycle-manager.api.lifecycle.v1alpha1.RolloutStrategy
+  map:
+    fields:
+    - name: canaryBatchSize
+      type:
+        scalar: numeric
+    - name: maxFailurePercentage
+      type:
+        scalar: numeric
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: pauseBetweenBatches
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
 - name: io.k8s.api.core.v1.LocalObjectReference
   map:
     fields:
@@ -393,6 +677,8 @@ var schemaYAML = typed.YAMLObject(`types:
     elementRelationship: atomic
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.Time
   scalar: untyped
+- name: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+  scalar: untyped
 - name: __untyped_atomic_
   scalar: untyped
   list:
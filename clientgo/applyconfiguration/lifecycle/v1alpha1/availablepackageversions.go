@@ -7,8 +7,9 @@ package v1alpha1
 // AvailablePackageVersionsApplyConfiguration represents an declarative configuration of the AvailablePackageVersions type for use
 // with apply.
 type AvailablePackageVersionsApplyConfiguration struct {
-	Name     *string  `json:"name,omitempty"`
-	Versions []string `json:"versions,omitempty"`
+	Name         *string                               `json:"name,omitempty"`
+	Versions     []string                              `json:"versions,omitempty"`
+	Dependencies []PackageDependencyApplyConfiguration `json:"dependencies,omitempty"`
 }
 
 // AvailablePackageVersionsApplyConfiguration constructs an declarative configuration of the AvailablePackageVersions type for use with
@@ -34,3 +35,16 @@ func (b *AvailablePackageVersionsApplyConfiguration) WithVersions(values ...stri
 	}
 	return b
 }
+
+// WithDependencies adds the given value to the Dependencies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Dependencies field.
+func (b *AvailablePackageVersionsApplyConfiguration) WithDependencies(values ...*PackageDependencyApplyConfiguration) *AvailablePackageVersionsApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependencies")
+		}
+		b.Dependencies = append(b.Dependencies, *values[i])
+	}
+	return b
+}
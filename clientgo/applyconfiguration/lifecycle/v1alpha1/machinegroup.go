@@ -5,15 +5,22 @@
 package v1alpha1
 
 import (
+	v1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	v1 "github.com/ironcore-dev/lifecycle-manager/clientgo/applyconfiguration/meta/v1"
 )
 
 // MachineGroupApplyConfiguration represents an declarative configuration of the MachineGroup type for use
 // with apply.
 type MachineGroupApplyConfiguration struct {
-	Name            *string                             `json:"name,omitempty"`
-	MachineSelector *v1.LabelSelectorApplyConfiguration `json:"machineSelector,omitempty"`
-	Packages        []PackageVersionApplyConfiguration  `json:"packages,omitempty"`
+	Name               *string                             `json:"name,omitempty"`
+	MachineSelector    *v1.LabelSelectorApplyConfiguration `json:"machineSelector,omitempty"`
+	Packages           []PackageVersionApplyConfiguration  `json:"packages,omitempty"`
+	Priority           *int32                              `json:"priority,omitempty"`
+	DowngradePolicy    *v1alpha1.DowngradePolicy           `json:"downgradePolicy,omitempty"`
+	Rollout            *RolloutStrategyApplyConfiguration  `json:"rollout,omitempty"`
+	MaintenanceWindows []string                            `json:"maintenanceWindows,omitempty"`
+	Approval           *v1alpha1.ApprovalMode              `json:"approval,omitempty"`
+	DriftPolicy        *v1alpha1.DriftPolicy               `json:"driftPolicy,omitempty"`
 }
 
 // MachineGroupApplyConfiguration constructs an declarative configuration of the MachineGroup type for use with
@@ -50,3 +57,53 @@ func (b *MachineGroupApplyConfiguration) WithPackages(values ...*PackageVersionA
 	}
 	return b
 }
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *MachineGroupApplyConfiguration) WithPriority(value int32) *MachineGroupApplyConfiguration {
+	b.Priority = &value
+	return b
+}
+
+// WithDowngradePolicy sets the DowngradePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DowngradePolicy field is set to the value of the last call.
+func (b *MachineGroupApplyConfiguration) WithDowngradePolicy(value v1alpha1.DowngradePolicy) *MachineGroupApplyConfiguration {
+	b.DowngradePolicy = &value
+	return b
+}
+
+// WithRollout sets the Rollout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Rollout field is set to the value of the last call.
+func (b *MachineGroupApplyConfiguration) WithRollout(value *RolloutStrategyApplyConfiguration) *MachineGroupApplyConfiguration {
+	b.Rollout = value
+	return b
+}
+
+// WithMaintenanceWindows adds the given value to the MaintenanceWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceWindows field.
+func (b *MachineGroupApplyConfiguration) WithMaintenanceWindows(values ...string) *MachineGroupApplyConfiguration {
+	for i := range values {
+		b.MaintenanceWindows = append(b.MaintenanceWindows, values[i])
+	}
+	return b
+}
+
+// WithApproval sets the Approval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Approval field is set to the value of the last call.
+func (b *MachineGroupApplyConfiguration) WithApproval(value v1alpha1.ApprovalMode) *MachineGroupApplyConfiguration {
+	b.Approval = &value
+	return b
+}
+
+// WithDriftPolicy sets the DriftPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DriftPolicy field is set to the value of the last call.
+func (b *MachineGroupApplyConfiguration) WithDriftPolicy(value v1alpha1.DriftPolicy) *MachineGroupApplyConfiguration {
+	b.DriftPolicy = &value
+	return b
+}
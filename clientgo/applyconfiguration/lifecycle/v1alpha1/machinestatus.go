@@ -13,11 +13,15 @@ import (
 // MachineStatusApplyConfiguration represents an declarative configuration of the MachineStatus type for use
 // with apply.
 type MachineStatusApplyConfiguration struct {
-	LastScanTime      *v1.Time                             `json:"lastScanTime,omitempty"`
-	LastScanResult    *v1alpha1.ScanResult                 `json:"lastScanResult,omitempty"`
-	InstalledPackages []PackageVersionApplyConfiguration   `json:"installedPackages,omitempty"`
-	Message           *string                              `json:"message,omitempty"`
-	Conditions        []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	LastScanTime      *v1.Time                                  `json:"lastScanTime,omitempty"`
+	LastScanResult    *v1alpha1.ScanResult                      `json:"lastScanResult,omitempty"`
+	InstalledPackages []PackageVersionApplyConfiguration        `json:"installedPackages,omitempty"`
+	DesiredPackages   []DesiredPackageVersionApplyConfiguration `json:"desiredPackages,omitempty"`
+	Message           *string                                   `json:"message,omitempty"`
+	Conditions        []metav1.ConditionApplyConfiguration      `json:"conditions,omitempty"`
+	Approval          *InstallApprovalApplyConfiguration        `json:"approval,omitempty"`
+	ApprovalHistory   []InstallApprovalApplyConfiguration       `json:"approvalHistory,omitempty"`
+	PackageStatuses   []PackageInstallStatusApplyConfiguration  `json:"packageStatuses,omitempty"`
 }
 
 // MachineStatusApplyConfiguration constructs an declarative configuration of the MachineStatus type for use with
@@ -55,6 +59,19 @@ func (b *MachineStatusApplyConfiguration) WithInstalledPackages(values ...*Packa
 	return b
 }
 
+// WithDesiredPackages adds the given value to the DesiredPackages field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DesiredPackages field.
+func (b *MachineStatusApplyConfiguration) WithDesiredPackages(values ...*DesiredPackageVersionApplyConfiguration) *MachineStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDesiredPackages")
+		}
+		b.DesiredPackages = append(b.DesiredPackages, *values[i])
+	}
+	return b
+}
+
 // WithMessage sets the Message field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Message field is set to the value of the last call.
@@ -75,3 +92,37 @@ func (b *MachineStatusApplyConfiguration) WithConditions(values ...*metav1.Condi
 	}
 	return b
 }
+
+// WithApproval sets the Approval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Approval field is set to the value of the last call.
+func (b *MachineStatusApplyConfiguration) WithApproval(value *InstallApprovalApplyConfiguration) *MachineStatusApplyConfiguration {
+	b.Approval = value
+	return b
+}
+
+// WithApprovalHistory adds the given value to the ApprovalHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ApprovalHistory field.
+func (b *MachineStatusApplyConfiguration) WithApprovalHistory(values ...*InstallApprovalApplyConfiguration) *MachineStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithApprovalHistory")
+		}
+		b.ApprovalHistory = append(b.ApprovalHistory, *values[i])
+	}
+	return b
+}
+
+// WithPackageStatuses adds the given value to the PackageStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PackageStatuses field.
+func (b *MachineStatusApplyConfiguration) WithPackageStatuses(values ...*PackageInstallStatusApplyConfiguration) *MachineStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPackageStatuses")
+		}
+		b.PackageStatuses = append(b.PackageStatuses, *values[i])
+	}
+	return b
+}
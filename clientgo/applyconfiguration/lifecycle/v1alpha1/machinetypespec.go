@@ -5,16 +5,19 @@
 package v1alpha1
 
 import (
+	v1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // MachineTypeSpecApplyConfiguration represents an declarative configuration of the MachineTypeSpec type for use
 // with apply.
 type MachineTypeSpecApplyConfiguration struct {
-	Manufacturer  *string                          `json:"manufacturer,omitempty"`
-	Type          *string                          `json:"type,omitempty"`
-	ScanPeriod    *v1.Duration                     `json:"scanPeriod,omitempty"`
-	MachineGroups []MachineGroupApplyConfiguration `json:"machineGroups,omitempty"`
+	Manufacturer       *string                               `json:"manufacturer,omitempty"`
+	Type               *string                               `json:"type,omitempty"`
+	ScanPeriod         *v1.Duration                          `json:"scanPeriod,omitempty"`
+	MachineGroups      []MachineGroupApplyConfiguration      `json:"machineGroups,omitempty"`
+	DowngradePolicy    *v1alpha1.DowngradePolicy             `json:"downgradePolicy,omitempty"`
+	MaintenanceWindows []MaintenanceWindowApplyConfiguration `json:"maintenanceWindows,omitempty"`
 }
 
 // MachineTypeSpecApplyConfiguration constructs an declarative configuration of the MachineTypeSpec type for use with
@@ -59,3 +62,24 @@ func (b *MachineTypeSpecApplyConfiguration) WithMachineGroups(values ...*Machine
 	}
 	return b
 }
+
+// WithDowngradePolicy sets the DowngradePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DowngradePolicy field is set to the value of the last call.
+func (b *MachineTypeSpecApplyConfiguration) WithDowngradePolicy(value v1alpha1.DowngradePolicy) *MachineTypeSpecApplyConfiguration {
+	b.DowngradePolicy = &value
+	return b
+}
+
+// WithMaintenanceWindows adds the given value to the MaintenanceWindows field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MaintenanceWindows field.
+func (b *MachineTypeSpecApplyConfiguration) WithMaintenanceWindows(values ...*MaintenanceWindowApplyConfiguration) *MachineTypeSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMaintenanceWindows")
+		}
+		b.MaintenanceWindows = append(b.MaintenanceWindows, *values[i])
+	}
+	return b
+}
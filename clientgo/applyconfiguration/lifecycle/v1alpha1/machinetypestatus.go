@@ -6,16 +6,19 @@ package v1alpha1
 
 import (
 	v1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	metav1 "github.com/ironcore-dev/lifecycle-manager/clientgo/applyconfiguration/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // MachineTypeStatusApplyConfiguration represents an declarative configuration of the MachineTypeStatus type for use
 // with apply.
 type MachineTypeStatusApplyConfiguration struct {
-	LastScanTime      *v1.Time                                     `json:"lastScanTime,omitempty"`
-	LastScanResult    *v1alpha1.ScanResult                         `json:"lastScanResult,omitempty"`
-	AvailablePackages []AvailablePackageVersionsApplyConfiguration `json:"availablePackages,omitempty"`
-	Message           *string                                      `json:"message,omitempty"`
+	LastScanTime      *v1.Time                                      `json:"lastScanTime,omitempty"`
+	LastScanResult    *v1alpha1.ScanResult                          `json:"lastScanResult,omitempty"`
+	AvailablePackages []AvailablePackageVersionsApplyConfiguration  `json:"availablePackages,omitempty"`
+	Message           *string                                       `json:"message,omitempty"`
+	Rollouts          []MachineGroupRolloutStatusApplyConfiguration `json:"rollouts,omitempty"`
+	Conditions        []metav1.ConditionApplyConfiguration          `json:"conditions,omitempty"`
 }
 
 // MachineTypeStatusApplyConfiguration constructs an declarative configuration of the MachineTypeStatus type for use with
@@ -60,3 +63,29 @@ func (b *MachineTypeStatusApplyConfiguration) WithMessage(value string) *Machine
 	b.Message = &value
 	return b
 }
+
+// WithRollouts adds the given value to the Rollouts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Rollouts field.
+func (b *MachineTypeStatusApplyConfiguration) WithRollouts(values ...*MachineGroupRolloutStatusApplyConfiguration) *MachineTypeStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRollouts")
+		}
+		b.Rollouts = append(b.Rollouts, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MachineTypeStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *MachineTypeStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
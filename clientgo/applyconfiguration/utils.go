@@ -19,10 +19,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=lifecycle.ironcore.dev, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithKind("AvailablePackageVersions"):
 		return &lifecyclev1alpha1.AvailablePackageVersionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DesiredPackageVersion"):
+		return &lifecyclev1alpha1.DesiredPackageVersionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FirmwareChange"):
+		return &lifecyclev1alpha1.FirmwareChangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InstallApproval"):
+		return &lifecyclev1alpha1.InstallApprovalApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Machine"):
 		return &lifecyclev1alpha1.MachineApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineGroup"):
 		return &lifecyclev1alpha1.MachineGroupApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineGroupRolloutStatus"):
+		return &lifecyclev1alpha1.MachineGroupRolloutStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineHistory"):
+		return &lifecyclev1alpha1.MachineHistoryApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineSpec"):
 		return &lifecyclev1alpha1.MachineSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineStatus"):
@@ -33,8 +43,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &lifecyclev1alpha1.MachineTypeSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineTypeStatus"):
 		return &lifecyclev1alpha1.MachineTypeStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MaintenanceWindow"):
+		return &lifecyclev1alpha1.MaintenanceWindowApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PackageDependency"):
+		return &lifecyclev1alpha1.PackageDependencyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PackageInstallStatus"):
+		return &lifecyclev1alpha1.PackageInstallStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PackageVersion"):
 		return &lifecyclev1alpha1.PackageVersionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RolloutStrategy"):
+		return &lifecyclev1alpha1.RolloutStrategyApplyConfiguration{}
 
 		// Group=meta.k8s.io, Version=v1
 	case v1.SchemeGroupVersion.WithKind("Condition"):
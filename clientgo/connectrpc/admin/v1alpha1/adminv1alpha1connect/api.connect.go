@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: admin/v1alpha1/api.proto
+
+package adminv1alpha1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/admin/v1alpha1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "admin.v1alpha1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServicePauseSchedulingProcedure is the fully-qualified name of the AdminService's
+	// PauseScheduling RPC.
+	AdminServicePauseSchedulingProcedure = "/admin.v1alpha1.AdminService/PauseScheduling"
+	// AdminServiceResumeSchedulingProcedure is the fully-qualified name of the AdminService's
+	// ResumeScheduling RPC.
+	AdminServiceResumeSchedulingProcedure = "/admin.v1alpha1.AdminService/ResumeScheduling"
+	// AdminServiceGetSchedulingStatusProcedure is the fully-qualified name of the AdminService's
+	// GetSchedulingStatus RPC.
+	AdminServiceGetSchedulingStatusProcedure = "/admin.v1alpha1.AdminService/GetSchedulingStatus"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	adminServiceServiceDescriptor                   = v1alpha1.File_admin_v1alpha1_api_proto.Services().ByName("AdminService")
+	adminServicePauseSchedulingMethodDescriptor     = adminServiceServiceDescriptor.Methods().ByName("PauseScheduling")
+	adminServiceResumeSchedulingMethodDescriptor    = adminServiceServiceDescriptor.Methods().ByName("ResumeScheduling")
+	adminServiceGetSchedulingStatusMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("GetSchedulingStatus")
+)
+
+// AdminServiceClient is a client for the admin.v1alpha1.AdminService service.
+type AdminServiceClient interface {
+	PauseScheduling(context.Context, *connect.Request[v1alpha1.PauseSchedulingRequest]) (*connect.Response[v1alpha1.PauseSchedulingResponse], error)
+	ResumeScheduling(context.Context, *connect.Request[v1alpha1.ResumeSchedulingRequest]) (*connect.Response[v1alpha1.ResumeSchedulingResponse], error)
+	GetSchedulingStatus(context.Context, *connect.Request[v1alpha1.GetSchedulingStatusRequest]) (*connect.Response[v1alpha1.GetSchedulingStatusResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the admin.v1alpha1.AdminService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &adminServiceClient{
+		pauseScheduling: connect.NewClient[v1alpha1.PauseSchedulingRequest, v1alpha1.PauseSchedulingResponse](
+			httpClient,
+			baseURL+AdminServicePauseSchedulingProcedure,
+			connect.WithSchema(adminServicePauseSchedulingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resumeScheduling: connect.NewClient[v1alpha1.ResumeSchedulingRequest, v1alpha1.ResumeSchedulingResponse](
+			httpClient,
+			baseURL+AdminServiceResumeSchedulingProcedure,
+			connect.WithSchema(adminServiceResumeSchedulingMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getSchedulingStatus: connect.NewClient[v1alpha1.GetSchedulingStatusRequest, v1alpha1.GetSchedulingStatusResponse](
+			httpClient,
+			baseURL+AdminServiceGetSchedulingStatusProcedure,
+			connect.WithSchema(adminServiceGetSchedulingStatusMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	pauseScheduling     *connect.Client[v1alpha1.PauseSchedulingRequest, v1alpha1.PauseSchedulingResponse]
+	resumeScheduling    *connect.Client[v1alpha1.ResumeSchedulingRequest, v1alpha1.ResumeSchedulingResponse]
+	getSchedulingStatus *connect.Client[v1alpha1.GetSchedulingStatusRequest, v1alpha1.GetSchedulingStatusResponse]
+}
+
+// PauseScheduling calls admin.v1alpha1.AdminService.PauseScheduling.
+func (c *adminServiceClient) PauseScheduling(ctx context.Context, req *connect.Request[v1alpha1.PauseSchedulingRequest]) (*connect.Response[v1alpha1.PauseSchedulingResponse], error) {
+	return c.pauseScheduling.CallUnary(ctx, req)
+}
+
+// ResumeScheduling calls admin.v1alpha1.AdminService.ResumeScheduling.
+func (c *adminServiceClient) ResumeScheduling(ctx context.Context, req *connect.Request[v1alpha1.ResumeSchedulingRequest]) (*connect.Response[v1alpha1.ResumeSchedulingResponse], error) {
+	return c.resumeScheduling.CallUnary(ctx, req)
+}
+
+// GetSchedulingStatus calls admin.v1alpha1.AdminService.GetSchedulingStatus.
+func (c *adminServiceClient) GetSchedulingStatus(ctx context.Context, req *connect.Request[v1alpha1.GetSchedulingStatusRequest]) (*connect.Response[v1alpha1.GetSchedulingStatusResponse], error) {
+	return c.getSchedulingStatus.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the admin.v1alpha1.AdminService service.
+type AdminServiceHandler interface {
+	PauseScheduling(context.Context, *connect.Request[v1alpha1.PauseSchedulingRequest]) (*connect.Response[v1alpha1.PauseSchedulingResponse], error)
+	ResumeScheduling(context.Context, *connect.Request[v1alpha1.ResumeSchedulingRequest]) (*connect.Response[v1alpha1.ResumeSchedulingResponse], error)
+	GetSchedulingStatus(context.Context, *connect.Request[v1alpha1.GetSchedulingStatusRequest]) (*connect.Response[v1alpha1.GetSchedulingStatusResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServicePauseSchedulingHandler := connect.NewUnaryHandler(
+		AdminServicePauseSchedulingProcedure,
+		svc.PauseScheduling,
+		connect.WithSchema(adminServicePauseSchedulingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceResumeSchedulingHandler := connect.NewUnaryHandler(
+		AdminServiceResumeSchedulingProcedure,
+		svc.ResumeScheduling,
+		connect.WithSchema(adminServiceResumeSchedulingMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetSchedulingStatusHandler := connect.NewUnaryHandler(
+		AdminServiceGetSchedulingStatusProcedure,
+		svc.GetSchedulingStatus,
+		connect.WithSchema(adminServiceGetSchedulingStatusMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/admin.v1alpha1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServicePauseSchedulingProcedure:
+			adminServicePauseSchedulingHandler.ServeHTTP(w, r)
+		case AdminServiceResumeSchedulingProcedure:
+			adminServiceResumeSchedulingHandler.ServeHTTP(w, r)
+		case AdminServiceGetSchedulingStatusProcedure:
+			adminServiceGetSchedulingStatusHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) PauseScheduling(context.Context, *connect.Request[v1alpha1.PauseSchedulingRequest]) (*connect.Response[v1alpha1.PauseSchedulingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1alpha1.AdminService.PauseScheduling is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ResumeScheduling(context.Context, *connect.Request[v1alpha1.ResumeSchedulingRequest]) (*connect.Response[v1alpha1.ResumeSchedulingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1alpha1.AdminService.ResumeScheduling is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetSchedulingStatus(context.Context, *connect.Request[v1alpha1.GetSchedulingStatusRequest]) (*connect.Response[v1alpha1.GetSchedulingStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1alpha1.AdminService.GetSchedulingStatus is not implemented"))
+}
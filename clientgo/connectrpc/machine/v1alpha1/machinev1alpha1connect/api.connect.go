@@ -42,6 +42,9 @@ const (
 	// MachineServiceUpdateMachineStatusProcedure is the fully-qualified name of the MachineService's
 	// UpdateMachineStatus RPC.
 	MachineServiceUpdateMachineStatusProcedure = "/machine.v1alpha1.MachineService/UpdateMachineStatus"
+	// MachineServiceUpdatePackageStatusProcedure is the fully-qualified name of the MachineService's
+	// UpdatePackageStatus RPC.
+	MachineServiceUpdatePackageStatusProcedure = "/machine.v1alpha1.MachineService/UpdatePackageStatus"
 	// MachineServiceListMachinesProcedure is the fully-qualified name of the MachineService's
 	// ListMachines RPC.
 	MachineServiceListMachinesProcedure = "/machine.v1alpha1.MachineService/ListMachines"
@@ -56,6 +59,24 @@ const (
 	MachineServiceRemovePackageVersionProcedure = "/machine.v1alpha1.MachineService/RemovePackageVersion"
 	// MachineServiceGetJobProcedure is the fully-qualified name of the MachineService's GetJob RPC.
 	MachineServiceGetJobProcedure = "/machine.v1alpha1.MachineService/GetJob"
+	// MachineServiceScanMachinesProcedure is the fully-qualified name of the MachineService's
+	// ScanMachines RPC.
+	MachineServiceScanMachinesProcedure = "/machine.v1alpha1.MachineService/ScanMachines"
+	// MachineServiceInstallMachinesProcedure is the fully-qualified name of the MachineService's
+	// InstallMachines RPC.
+	MachineServiceInstallMachinesProcedure = "/machine.v1alpha1.MachineService/InstallMachines"
+	// MachineServiceSetPackageVersionsProcedure is the fully-qualified name of the MachineService's
+	// SetPackageVersions RPC.
+	MachineServiceSetPackageVersionsProcedure = "/machine.v1alpha1.MachineService/SetPackageVersions"
+	// MachineServiceGetInstallPlanProcedure is the fully-qualified name of the MachineService's
+	// GetInstallPlan RPC.
+	MachineServiceGetInstallPlanProcedure = "/machine.v1alpha1.MachineService/GetInstallPlan"
+	// MachineServiceApproveInstallProcedure is the fully-qualified name of the MachineService's
+	// ApproveInstall RPC.
+	MachineServiceApproveInstallProcedure = "/machine.v1alpha1.MachineService/ApproveInstall"
+	// MachineServiceGetMachineHistoryProcedure is the fully-qualified name of the MachineService's
+	// GetMachineHistory RPC.
+	MachineServiceGetMachineHistoryProcedure = "/machine.v1alpha1.MachineService/GetMachineHistory"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -64,11 +85,18 @@ var (
 	machineServiceScanMachineMethodDescriptor          = machineServiceServiceDescriptor.Methods().ByName("ScanMachine")
 	machineServiceInstallMethodDescriptor              = machineServiceServiceDescriptor.Methods().ByName("Install")
 	machineServiceUpdateMachineStatusMethodDescriptor  = machineServiceServiceDescriptor.Methods().ByName("UpdateMachineStatus")
+	machineServiceUpdatePackageStatusMethodDescriptor  = machineServiceServiceDescriptor.Methods().ByName("UpdatePackageStatus")
 	machineServiceListMachinesMethodDescriptor         = machineServiceServiceDescriptor.Methods().ByName("ListMachines")
 	machineServiceAddPackageVersionMethodDescriptor    = machineServiceServiceDescriptor.Methods().ByName("AddPackageVersion")
 	machineServiceSetPackageVersionMethodDescriptor    = machineServiceServiceDescriptor.Methods().ByName("SetPackageVersion")
 	machineServiceRemovePackageVersionMethodDescriptor = machineServiceServiceDescriptor.Methods().ByName("RemovePackageVersion")
 	machineServiceGetJobMethodDescriptor               = machineServiceServiceDescriptor.Methods().ByName("GetJob")
+	machineServiceScanMachinesMethodDescriptor         = machineServiceServiceDescriptor.Methods().ByName("ScanMachines")
+	machineServiceInstallMachinesMethodDescriptor      = machineServiceServiceDescriptor.Methods().ByName("InstallMachines")
+	machineServiceSetPackageVersionsMethodDescriptor   = machineServiceServiceDescriptor.Methods().ByName("SetPackageVersions")
+	machineServiceGetInstallPlanMethodDescriptor       = machineServiceServiceDescriptor.Methods().ByName("GetInstallPlan")
+	machineServiceApproveInstallMethodDescriptor       = machineServiceServiceDescriptor.Methods().ByName("ApproveInstall")
+	machineServiceGetMachineHistoryMethodDescriptor    = machineServiceServiceDescriptor.Methods().ByName("GetMachineHistory")
 )
 
 // MachineServiceClient is a client for the machine.v1alpha1.MachineService service.
@@ -76,11 +104,18 @@ type MachineServiceClient interface {
 	ScanMachine(context.Context, *connect.Request[v1alpha1.ScanMachineRequest]) (*connect.Response[v1alpha1.ScanMachineResponse], error)
 	Install(context.Context, *connect.Request[v1alpha1.InstallRequest]) (*connect.Response[v1alpha1.InstallResponse], error)
 	UpdateMachineStatus(context.Context, *connect.Request[v1alpha1.UpdateMachineStatusRequest]) (*connect.Response[v1alpha1.UpdateMachineStatusResponse], error)
+	UpdatePackageStatus(context.Context, *connect.Request[v1alpha1.UpdatePackageStatusRequest]) (*connect.Response[v1alpha1.UpdatePackageStatusResponse], error)
 	ListMachines(context.Context, *connect.Request[v1alpha1.ListMachinesRequest]) (*connect.Response[v1alpha1.ListMachinesResponse], error)
 	AddPackageVersion(context.Context, *connect.Request[v1alpha1.AddPackageVersionRequest]) (*connect.Response[v1alpha1.AddPackageVersionResponse], error)
 	SetPackageVersion(context.Context, *connect.Request[v1alpha1.SetPackageVersionRequest]) (*connect.Response[v1alpha1.SetPackageVersionResponse], error)
 	RemovePackageVersion(context.Context, *connect.Request[v1alpha1.RemovePackageVersionRequest]) (*connect.Response[v1alpha1.RemovePackageVersionResponse], error)
 	GetJob(context.Context, *connect.Request[v1alpha1.GetJobRequest]) (*connect.Response[v1alpha1.GetJobResponse], error)
+	ScanMachines(context.Context, *connect.Request[v1alpha1.ScanMachinesRequest]) (*connect.Response[v1alpha1.ScanMachinesResponse], error)
+	InstallMachines(context.Context, *connect.Request[v1alpha1.InstallMachinesRequest]) (*connect.Response[v1alpha1.InstallMachinesResponse], error)
+	SetPackageVersions(context.Context, *connect.Request[v1alpha1.SetPackageVersionsRequest]) (*connect.Response[v1alpha1.SetPackageVersionsResponse], error)
+	GetInstallPlan(context.Context, *connect.Request[v1alpha1.GetInstallPlanRequest]) (*connect.Response[v1alpha1.GetInstallPlanResponse], error)
+	ApproveInstall(context.Context, *connect.Request[v1alpha1.ApproveInstallRequest]) (*connect.Response[v1alpha1.ApproveInstallResponse], error)
+	GetMachineHistory(context.Context, *connect.Request[v1alpha1.GetMachineHistoryRequest]) (*connect.Response[v1alpha1.GetMachineHistoryResponse], error)
 }
 
 // NewMachineServiceClient constructs a client for the machine.v1alpha1.MachineService service. By
@@ -111,6 +146,12 @@ func NewMachineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(machineServiceUpdateMachineStatusMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		updatePackageStatus: connect.NewClient[v1alpha1.UpdatePackageStatusRequest, v1alpha1.UpdatePackageStatusResponse](
+			httpClient,
+			baseURL+MachineServiceUpdatePackageStatusProcedure,
+			connect.WithSchema(machineServiceUpdatePackageStatusMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listMachines: connect.NewClient[v1alpha1.ListMachinesRequest, v1alpha1.ListMachinesResponse](
 			httpClient,
 			baseURL+MachineServiceListMachinesProcedure,
@@ -141,6 +182,42 @@ func NewMachineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(machineServiceGetJobMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		scanMachines: connect.NewClient[v1alpha1.ScanMachinesRequest, v1alpha1.ScanMachinesResponse](
+			httpClient,
+			baseURL+MachineServiceScanMachinesProcedure,
+			connect.WithSchema(machineServiceScanMachinesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		installMachines: connect.NewClient[v1alpha1.InstallMachinesRequest, v1alpha1.InstallMachinesResponse](
+			httpClient,
+			baseURL+MachineServiceInstallMachinesProcedure,
+			connect.WithSchema(machineServiceInstallMachinesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setPackageVersions: connect.NewClient[v1alpha1.SetPackageVersionsRequest, v1alpha1.SetPackageVersionsResponse](
+			httpClient,
+			baseURL+MachineServiceSetPackageVersionsProcedure,
+			connect.WithSchema(machineServiceSetPackageVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getInstallPlan: connect.NewClient[v1alpha1.GetInstallPlanRequest, v1alpha1.GetInstallPlanResponse](
+			httpClient,
+			baseURL+MachineServiceGetInstallPlanProcedure,
+			connect.WithSchema(machineServiceGetInstallPlanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		approveInstall: connect.NewClient[v1alpha1.ApproveInstallRequest, v1alpha1.ApproveInstallResponse](
+			httpClient,
+			baseURL+MachineServiceApproveInstallProcedure,
+			connect.WithSchema(machineServiceApproveInstallMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMachineHistory: connect.NewClient[v1alpha1.GetMachineHistoryRequest, v1alpha1.GetMachineHistoryResponse](
+			httpClient,
+			baseURL+MachineServiceGetMachineHistoryProcedure,
+			connect.WithSchema(machineServiceGetMachineHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -149,11 +226,18 @@ type machineServiceClient struct {
 	scanMachine          *connect.Client[v1alpha1.ScanMachineRequest, v1alpha1.ScanMachineResponse]
 	install              *connect.Client[v1alpha1.InstallRequest, v1alpha1.InstallResponse]
 	updateMachineStatus  *connect.Client[v1alpha1.UpdateMachineStatusRequest, v1alpha1.UpdateMachineStatusResponse]
+	updatePackageStatus  *connect.Client[v1alpha1.UpdatePackageStatusRequest, v1alpha1.UpdatePackageStatusResponse]
 	listMachines         *connect.Client[v1alpha1.ListMachinesRequest, v1alpha1.ListMachinesResponse]
 	addPackageVersion    *connect.Client[v1alpha1.AddPackageVersionRequest, v1alpha1.AddPackageVersionResponse]
 	setPackageVersion    *connect.Client[v1alpha1.SetPackageVersionRequest, v1alpha1.SetPackageVersionResponse]
 	removePackageVersion *connect.Client[v1alpha1.RemovePackageVersionRequest, v1alpha1.RemovePackageVersionResponse]
 	getJob               *connect.Client[v1alpha1.GetJobRequest, v1alpha1.GetJobResponse]
+	scanMachines         *connect.Client[v1alpha1.ScanMachinesRequest, v1alpha1.ScanMachinesResponse]
+	installMachines      *connect.Client[v1alpha1.InstallMachinesRequest, v1alpha1.InstallMachinesResponse]
+	setPackageVersions   *connect.Client[v1alpha1.SetPackageVersionsRequest, v1alpha1.SetPackageVersionsResponse]
+	getInstallPlan       *connect.Client[v1alpha1.GetInstallPlanRequest, v1alpha1.GetInstallPlanResponse]
+	approveInstall       *connect.Client[v1alpha1.ApproveInstallRequest, v1alpha1.ApproveInstallResponse]
+	getMachineHistory    *connect.Client[v1alpha1.GetMachineHistoryRequest, v1alpha1.GetMachineHistoryResponse]
 }
 
 // ScanMachine calls machine.v1alpha1.MachineService.ScanMachine.
@@ -171,6 +255,11 @@ func (c *machineServiceClient) UpdateMachineStatus(ctx context.Context, req *con
 	return c.updateMachineStatus.CallUnary(ctx, req)
 }
 
+// UpdatePackageStatus calls machine.v1alpha1.MachineService.UpdatePackageStatus.
+func (c *machineServiceClient) UpdatePackageStatus(ctx context.Context, req *connect.Request[v1alpha1.UpdatePackageStatusRequest]) (*connect.Response[v1alpha1.UpdatePackageStatusResponse], error) {
+	return c.updatePackageStatus.CallUnary(ctx, req)
+}
+
 // ListMachines calls machine.v1alpha1.MachineService.ListMachines.
 func (c *machineServiceClient) ListMachines(ctx context.Context, req *connect.Request[v1alpha1.ListMachinesRequest]) (*connect.Response[v1alpha1.ListMachinesResponse], error) {
 	return c.listMachines.CallUnary(ctx, req)
@@ -196,16 +285,53 @@ func (c *machineServiceClient) GetJob(ctx context.Context, req *connect.Request[
 	return c.getJob.CallUnary(ctx, req)
 }
 
+// ScanMachines calls machine.v1alpha1.MachineService.ScanMachines.
+func (c *machineServiceClient) ScanMachines(ctx context.Context, req *connect.Request[v1alpha1.ScanMachinesRequest]) (*connect.Response[v1alpha1.ScanMachinesResponse], error) {
+	return c.scanMachines.CallUnary(ctx, req)
+}
+
+// InstallMachines calls machine.v1alpha1.MachineService.InstallMachines.
+func (c *machineServiceClient) InstallMachines(ctx context.Context, req *connect.Request[v1alpha1.InstallMachinesRequest]) (*connect.Response[v1alpha1.InstallMachinesResponse], error) {
+	return c.installMachines.CallUnary(ctx, req)
+}
+
+// SetPackageVersions calls machine.v1alpha1.MachineService.SetPackageVersions.
+func (c *machineServiceClient) SetPackageVersions(ctx context.Context, req *connect.Request[v1alpha1.SetPackageVersionsRequest]) (*connect.Response[v1alpha1.SetPackageVersionsResponse], error) {
+	return c.setPackageVersions.CallUnary(ctx, req)
+}
+
+// GetInstallPlan calls machine.v1alpha1.MachineService.GetInstallPlan.
+func (c *machineServiceClient) GetInstallPlan(ctx context.Context, req *connect.Request[v1alpha1.GetInstallPlanRequest]) (*connect.Response[v1alpha1.GetInstallPlanResponse], error) {
+	return c.getInstallPlan.CallUnary(ctx, req)
+}
+
+// ApproveInstall calls machine.v1alpha1.MachineService.ApproveInstall.
+func (c *machineServiceClient) ApproveInstall(ctx context.Context, req *connect.Request[v1alpha1.ApproveInstallRequest]) (*connect.Response[v1alpha1.ApproveInstallResponse], error) {
+	return c.approveInstall.CallUnary(ctx, req)
+}
+
+// GetMachineHistory calls machine.v1alpha1.MachineService.GetMachineHistory.
+func (c *machineServiceClient) GetMachineHistory(ctx context.Context, req *connect.Request[v1alpha1.GetMachineHistoryRequest]) (*connect.Response[v1alpha1.GetMachineHistoryResponse], error) {
+	return c.getMachineHistory.CallUnary(ctx, req)
+}
+
 // MachineServiceHandler is an implementation of the machine.v1alpha1.MachineService service.
 type MachineServiceHandler interface {
 	ScanMachine(context.Context, *connect.Request[v1alpha1.ScanMachineRequest]) (*connect.Response[v1alpha1.ScanMachineResponse], error)
 	Install(context.Context, *connect.Request[v1alpha1.InstallRequest]) (*connect.Response[v1alpha1.InstallResponse], error)
 	UpdateMachineStatus(context.Context, *connect.Request[v1alpha1.UpdateMachineStatusRequest]) (*connect.Response[v1alpha1.UpdateMachineStatusResponse], error)
+	UpdatePackageStatus(context.Context, *connect.Request[v1alpha1.UpdatePackageStatusRequest]) (*connect.Response[v1alpha1.UpdatePackageStatusResponse], error)
 	ListMachines(context.Context, *connect.Request[v1alpha1.ListMachinesRequest]) (*connect.Response[v1alpha1.ListMachinesResponse], error)
 	AddPackageVersion(context.Context, *connect.Request[v1alpha1.AddPackageVersionRequest]) (*connect.Response[v1alpha1.AddPackageVersionResponse], error)
 	SetPackageVersion(context.Context, *connect.Request[v1alpha1.SetPackageVersionRequest]) (*connect.Response[v1alpha1.SetPackageVersionResponse], error)
 	RemovePackageVersion(context.Context, *connect.Request[v1alpha1.RemovePackageVersionRequest]) (*connect.Response[v1alpha1.RemovePackageVersionResponse], error)
 	GetJob(context.Context, *connect.Request[v1alpha1.GetJobRequest]) (*connect.Response[v1alpha1.GetJobResponse], error)
+	ScanMachines(context.Context, *connect.Request[v1alpha1.ScanMachinesRequest]) (*connect.Response[v1alpha1.ScanMachinesResponse], error)
+	InstallMachines(context.Context, *connect.Request[v1alpha1.InstallMachinesRequest]) (*connect.Response[v1alpha1.InstallMachinesResponse], error)
+	SetPackageVersions(context.Context, *connect.Request[v1alpha1.SetPackageVersionsRequest]) (*connect.Response[v1alpha1.SetPackageVersionsResponse], error)
+	GetInstallPlan(context.Context, *connect.Request[v1alpha1.GetInstallPlanRequest]) (*connect.Response[v1alpha1.GetInstallPlanResponse], error)
+	ApproveInstall(context.Context, *connect.Request[v1alpha1.ApproveInstallRequest]) (*connect.Response[v1alpha1.ApproveInstallResponse], error)
+	GetMachineHistory(context.Context, *connect.Request[v1alpha1.GetMachineHistoryRequest]) (*connect.Response[v1alpha1.GetMachineHistoryResponse], error)
 }
 
 // NewMachineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -232,6 +358,12 @@ func NewMachineServiceHandler(svc MachineServiceHandler, opts ...connect.Handler
 		connect.WithSchema(machineServiceUpdateMachineStatusMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	machineServiceUpdatePackageStatusHandler := connect.NewUnaryHandler(
+		MachineServiceUpdatePackageStatusProcedure,
+		svc.UpdatePackageStatus,
+		connect.WithSchema(machineServiceUpdatePackageStatusMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	machineServiceListMachinesHandler := connect.NewUnaryHandler(
 		MachineServiceListMachinesProcedure,
 		svc.ListMachines,
@@ -262,6 +394,42 @@ func NewMachineServiceHandler(svc MachineServiceHandler, opts ...connect.Handler
 		connect.WithSchema(machineServiceGetJobMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	machineServiceScanMachinesHandler := connect.NewUnaryHandler(
+		MachineServiceScanMachinesProcedure,
+		svc.ScanMachines,
+		connect.WithSchema(machineServiceScanMachinesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	machineServiceInstallMachinesHandler := connect.NewUnaryHandler(
+		MachineServiceInstallMachinesProcedure,
+		svc.InstallMachines,
+		connect.WithSchema(machineServiceInstallMachinesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	machineServiceSetPackageVersionsHandler := connect.NewUnaryHandler(
+		MachineServiceSetPackageVersionsProcedure,
+		svc.SetPackageVersions,
+		connect.WithSchema(machineServiceSetPackageVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	machineServiceGetInstallPlanHandler := connect.NewUnaryHandler(
+		MachineServiceGetInstallPlanProcedure,
+		svc.GetInstallPlan,
+		connect.WithSchema(machineServiceGetInstallPlanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	machineServiceApproveInstallHandler := connect.NewUnaryHandler(
+		MachineServiceApproveInstallProcedure,
+		svc.ApproveInstall,
+		connect.WithSchema(machineServiceApproveInstallMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	machineServiceGetMachineHistoryHandler := connect.NewUnaryHandler(
+		MachineServiceGetMachineHistoryProcedure,
+		svc.GetMachineHistory,
+		connect.WithSchema(machineServiceGetMachineHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/machine.v1alpha1.MachineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MachineServiceScanMachineProcedure:
@@ -270,6 +438,8 @@ func NewMachineServiceHandler(svc MachineServiceHandler, opts ...connect.Handler
 			machineServiceInstallHandler.ServeHTTP(w, r)
 		case MachineServiceUpdateMachineStatusProcedure:
 			machineServiceUpdateMachineStatusHandler.ServeHTTP(w, r)
+		case MachineServiceUpdatePackageStatusProcedure:
+			machineServiceUpdatePackageStatusHandler.ServeHTTP(w, r)
 		case MachineServiceListMachinesProcedure:
 			machineServiceListMachinesHandler.ServeHTTP(w, r)
 		case MachineServiceAddPackageVersionProcedure:
@@ -280,6 +450,18 @@ func NewMachineServiceHandler(svc MachineServiceHandler, opts ...connect.Handler
 			machineServiceRemovePackageVersionHandler.ServeHTTP(w, r)
 		case MachineServiceGetJobProcedure:
 			machineServiceGetJobHandler.ServeHTTP(w, r)
+		case MachineServiceScanMachinesProcedure:
+			machineServiceScanMachinesHandler.ServeHTTP(w, r)
+		case MachineServiceInstallMachinesProcedure:
+			machineServiceInstallMachinesHandler.ServeHTTP(w, r)
+		case MachineServiceSetPackageVersionsProcedure:
+			machineServiceSetPackageVersionsHandler.ServeHTTP(w, r)
+		case MachineServiceGetInstallPlanProcedure:
+			machineServiceGetInstallPlanHandler.ServeHTTP(w, r)
+		case MachineServiceApproveInstallProcedure:
+			machineServiceApproveInstallHandler.ServeHTTP(w, r)
+		case MachineServiceGetMachineHistoryProcedure:
+			machineServiceGetMachineHistoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -301,6 +483,10 @@ func (UnimplementedMachineServiceHandler) UpdateMachineStatus(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.UpdateMachineStatus is not implemented"))
 }
 
+func (UnimplementedMachineServiceHandler) UpdatePackageStatus(context.Context, *connect.Request[v1alpha1.UpdatePackageStatusRequest]) (*connect.Response[v1alpha1.UpdatePackageStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.UpdatePackageStatus is not implemented"))
+}
+
 func (UnimplementedMachineServiceHandler) ListMachines(context.Context, *connect.Request[v1alpha1.ListMachinesRequest]) (*connect.Response[v1alpha1.ListMachinesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.ListMachines is not implemented"))
 }
@@ -320,3 +506,27 @@ func (UnimplementedMachineServiceHandler) RemovePackageVersion(context.Context,
 func (UnimplementedMachineServiceHandler) GetJob(context.Context, *connect.Request[v1alpha1.GetJobRequest]) (*connect.Response[v1alpha1.GetJobResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.GetJob is not implemented"))
 }
+
+func (UnimplementedMachineServiceHandler) ScanMachines(context.Context, *connect.Request[v1alpha1.ScanMachinesRequest]) (*connect.Response[v1alpha1.ScanMachinesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.ScanMachines is not implemented"))
+}
+
+func (UnimplementedMachineServiceHandler) InstallMachines(context.Context, *connect.Request[v1alpha1.InstallMachinesRequest]) (*connect.Response[v1alpha1.InstallMachinesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.InstallMachines is not implemented"))
+}
+
+func (UnimplementedMachineServiceHandler) SetPackageVersions(context.Context, *connect.Request[v1alpha1.SetPackageVersionsRequest]) (*connect.Response[v1alpha1.SetPackageVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.SetPackageVersions is not implemented"))
+}
+
+func (UnimplementedMachineServiceHandler) GetInstallPlan(context.Context, *connect.Request[v1alpha1.GetInstallPlanRequest]) (*connect.Response[v1alpha1.GetInstallPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.GetInstallPlan is not implemented"))
+}
+
+func (UnimplementedMachineServiceHandler) ApproveInstall(context.Context, *connect.Request[v1alpha1.ApproveInstallRequest]) (*connect.Response[v1alpha1.ApproveInstallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.ApproveInstall is not implemented"))
+}
+
+func (UnimplementedMachineServiceHandler) GetMachineHistory(context.Context, *connect.Request[v1alpha1.GetMachineHistoryRequest]) (*connect.Response[v1alpha1.GetMachineHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("machine.v1alpha1.MachineService.GetMachineHistory is not implemented"))
+}
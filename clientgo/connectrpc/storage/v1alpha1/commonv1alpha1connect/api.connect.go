@@ -46,6 +46,9 @@ const (
 	// FirmwareStorageServiceDownloadProcedure is the fully-qualified name of the
 	// FirmwareStorageService's Download RPC.
 	FirmwareStorageServiceDownloadProcedure = "/common.v1alpha1.FirmwareStorageService/Download"
+	// FirmwareStorageServiceListPackagesProcedure is the fully-qualified name of the
+	// FirmwareStorageService's ListPackages RPC.
+	FirmwareStorageServiceListPackagesProcedure = "/common.v1alpha1.FirmwareStorageService/ListPackages"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -55,6 +58,7 @@ var (
 	firmwareStorageServiceUploadMethodDescriptor       = firmwareStorageServiceServiceDescriptor.Methods().ByName("Upload")
 	firmwareStorageServiceInitDownloadMethodDescriptor = firmwareStorageServiceServiceDescriptor.Methods().ByName("InitDownload")
 	firmwareStorageServiceDownloadMethodDescriptor     = firmwareStorageServiceServiceDescriptor.Methods().ByName("Download")
+	firmwareStorageServiceListPackagesMethodDescriptor = firmwareStorageServiceServiceDescriptor.Methods().ByName("ListPackages")
 )
 
 // FirmwareStorageServiceClient is a client for the common.v1alpha1.FirmwareStorageService service.
@@ -63,6 +67,7 @@ type FirmwareStorageServiceClient interface {
 	Upload(context.Context) *connect.ClientStreamForClient[v1alpha1.UploadRequest, v1alpha1.UploadResponse]
 	InitDownload(context.Context, *connect.Request[v1alpha1.InitDownloadRequest]) (*connect.Response[v1alpha1.InitDownloadResponse], error)
 	Download(context.Context, *connect.Request[v1alpha1.DownloadRequest]) (*connect.ServerStreamForClient[v1alpha1.DownloadResponse], error)
+	ListPackages(context.Context, *connect.Request[v1alpha1.ListPackagesRequest]) (*connect.Response[v1alpha1.ListPackagesResponse], error)
 }
 
 // NewFirmwareStorageServiceClient constructs a client for the
@@ -99,6 +104,12 @@ func NewFirmwareStorageServiceClient(httpClient connect.HTTPClient, baseURL stri
 			connect.WithSchema(firmwareStorageServiceDownloadMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listPackages: connect.NewClient[v1alpha1.ListPackagesRequest, v1alpha1.ListPackagesResponse](
+			httpClient,
+			baseURL+FirmwareStorageServiceListPackagesProcedure,
+			connect.WithSchema(firmwareStorageServiceListPackagesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -108,6 +119,7 @@ type firmwareStorageServiceClient struct {
 	upload       *connect.Client[v1alpha1.UploadRequest, v1alpha1.UploadResponse]
 	initDownload *connect.Client[v1alpha1.InitDownloadRequest, v1alpha1.InitDownloadResponse]
 	download     *connect.Client[v1alpha1.DownloadRequest, v1alpha1.DownloadResponse]
+	listPackages *connect.Client[v1alpha1.ListPackagesRequest, v1alpha1.ListPackagesResponse]
 }
 
 // InitUpload calls common.v1alpha1.FirmwareStorageService.InitUpload.
@@ -130,6 +142,11 @@ func (c *firmwareStorageServiceClient) Download(ctx context.Context, req *connec
 	return c.download.CallServerStream(ctx, req)
 }
 
+// ListPackages calls common.v1alpha1.FirmwareStorageService.ListPackages.
+func (c *firmwareStorageServiceClient) ListPackages(ctx context.Context, req *connect.Request[v1alpha1.ListPackagesRequest]) (*connect.Response[v1alpha1.ListPackagesResponse], error) {
+	return c.listPackages.CallUnary(ctx, req)
+}
+
 // FirmwareStorageServiceHandler is an implementation of the common.v1alpha1.FirmwareStorageService
 // service.
 type FirmwareStorageServiceHandler interface {
@@ -137,6 +154,7 @@ type FirmwareStorageServiceHandler interface {
 	Upload(context.Context, *connect.ClientStream[v1alpha1.UploadRequest]) (*connect.Response[v1alpha1.UploadResponse], error)
 	InitDownload(context.Context, *connect.Request[v1alpha1.InitDownloadRequest]) (*connect.Response[v1alpha1.InitDownloadResponse], error)
 	Download(context.Context, *connect.Request[v1alpha1.DownloadRequest], *connect.ServerStream[v1alpha1.DownloadResponse]) error
+	ListPackages(context.Context, *connect.Request[v1alpha1.ListPackagesRequest]) (*connect.Response[v1alpha1.ListPackagesResponse], error)
 }
 
 // NewFirmwareStorageServiceHandler builds an HTTP handler from the service implementation. It
@@ -169,6 +187,12 @@ func NewFirmwareStorageServiceHandler(svc FirmwareStorageServiceHandler, opts ..
 		connect.WithSchema(firmwareStorageServiceDownloadMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	firmwareStorageServiceListPackagesHandler := connect.NewUnaryHandler(
+		FirmwareStorageServiceListPackagesProcedure,
+		svc.ListPackages,
+		connect.WithSchema(firmwareStorageServiceListPackagesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/common.v1alpha1.FirmwareStorageService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FirmwareStorageServiceInitUploadProcedure:
@@ -179,6 +203,8 @@ func NewFirmwareStorageServiceHandler(svc FirmwareStorageServiceHandler, opts ..
 			firmwareStorageServiceInitDownloadHandler.ServeHTTP(w, r)
 		case FirmwareStorageServiceDownloadProcedure:
 			firmwareStorageServiceDownloadHandler.ServeHTTP(w, r)
+		case FirmwareStorageServiceListPackagesProcedure:
+			firmwareStorageServiceListPackagesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -203,3 +229,7 @@ func (UnimplementedFirmwareStorageServiceHandler) InitDownload(context.Context,
 func (UnimplementedFirmwareStorageServiceHandler) Download(context.Context, *connect.Request[v1alpha1.DownloadRequest], *connect.ServerStream[v1alpha1.DownloadResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("common.v1alpha1.FirmwareStorageService.Download is not implemented"))
 }
+
+func (UnimplementedFirmwareStorageServiceHandler) ListPackages(context.Context, *connect.Request[v1alpha1.ListPackagesRequest]) (*connect.Response[v1alpha1.ListPackagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("common.v1alpha1.FirmwareStorageService.ListPackages is not implemented"))
+}
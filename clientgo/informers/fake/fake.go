@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake provides a test setup for consumers of shared informers of
+// lifecycle.ironcore.dev API group, which is backed by fake clientset.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/informers/externalversions"
+	lifecyclefake "github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle/fake"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// Fixture bundles fake clientset and shared informer factory on top of it.
+//
+// Fake clientset does not replay objects created between informer's initial
+// list and watch, so changes made right after cache sync might be lost. To
+// avoid flaky tests, Fixture tracks established watches and Start returns
+// only when every informer is watching.
+type Fixture struct {
+	Clientset *lifecyclefake.Clientset
+	Factory   externalversions.SharedInformerFactory
+
+	watches chan struct{}
+}
+
+// NewFixture returns Fixture with fake clientset seeded with objects.
+func NewFixture(objects ...runtime.Object) *Fixture {
+	f := &Fixture{
+		Clientset: lifecyclefake.NewSimpleClientset(objects...),
+		watches:   make(chan struct{}, 64),
+	}
+	f.Clientset.PrependWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := f.Clientset.Tracker().Watch(action.GetResource(), action.GetNamespace())
+		if err != nil {
+			return false, nil, err
+		}
+		select {
+		case f.watches <- struct{}{}:
+		default:
+			// nobody waits for re-established watches
+		}
+		return true, w, nil
+	})
+	f.Factory = externalversions.NewSharedInformerFactory(f.Clientset, time.Duration(0))
+	return f
+}
+
+// Start runs informers requested from the factory, waits for cache sync and
+// for watches to be established. Informers must be requested before Start.
+func (f *Fixture) Start(ctx context.Context) error {
+	f.Factory.Start(ctx.Done())
+	synced := f.Factory.WaitForCacheSync(ctx.Done())
+	for informer, ok := range synced {
+		if !ok {
+			return fmt.Errorf("failed to sync %s informer", informer.String())
+		}
+	}
+	return f.WaitForWatches(ctx, len(synced))
+}
+
+// WaitForWatches blocks until count watches are established on the fake
+// clientset. It is useful when informers are created outside of Factory,
+// e.g. by the code under test.
+func (f *Fixture) WaitForWatches(ctx context.Context, count int) error {
+	for i := 0; i < count; i++ {
+		select {
+		case <-f.watches:
+		case <-ctx.Done():
+			return fmt.Errorf("%d of %d watches established: %w", i, count, ctx.Err())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ = Describe("Fixture", func() {
+	It("Should deliver seeded objects and further changes to informers", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		fixture := NewFixture(&lifecyclev1alpha1.MachineType{
+			ObjectMeta: metav1.ObjectMeta{Name: "sample-machinetype", Namespace: "default"},
+		})
+		machines := fixture.Factory.Lifecycle().V1alpha1().Machines()
+		machineTypes := fixture.Factory.Lifecycle().V1alpha1().MachineTypes()
+		added := make(chan string, 1)
+		_, err := machines.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if machine, ok := obj.(*lifecyclev1alpha1.Machine); ok {
+					added <- machine.Name
+				}
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_ = machineTypes.Informer()
+		Expect(fixture.Start(ctx)).To(Succeed())
+
+		items, err := machineTypes.Lister().List(labels.Everything())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(HaveLen(1))
+
+		_, err = fixture.Clientset.LifecycleV1alpha1().Machines("default").Create(ctx, &lifecyclev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: "sample-machine", Namespace: "default"},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(added).Should(Receive(Equal("sample-machine")))
+		Eventually(func() error {
+			_, err := machines.Lister().Machines("default").Get("sample-machine")
+			return err
+		}).Should(Succeed())
+	})
+})
@@ -18,6 +18,10 @@ func (c *FakeLifecycleV1alpha1) Machines(namespace string) v1alpha1.MachineInter
 	return &FakeMachines{c, namespace}
 }
 
+func (c *FakeLifecycleV1alpha1) MachineHistories(namespace string) v1alpha1.MachineHistoryInterface {
+	return &FakeMachineHistories{c, namespace}
+}
+
 func (c *FakeLifecycleV1alpha1) MachineTypes(namespace string) v1alpha1.MachineTypeInterface {
 	return &FakeMachineTypes{c, namespace}
 }
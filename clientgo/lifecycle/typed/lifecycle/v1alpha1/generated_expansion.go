@@ -6,4 +6,6 @@ package v1alpha1
 
 type MachineExpansion interface{}
 
+type MachineHistoryExpansion interface{}
+
 type MachineTypeExpansion interface{}
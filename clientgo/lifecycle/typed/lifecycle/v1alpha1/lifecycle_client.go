@@ -15,6 +15,7 @@ import (
 type LifecycleV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	MachinesGetter
+	MachineHistoriesGetter
 	MachineTypesGetter
 }
 
@@ -27,6 +28,10 @@ func (c *LifecycleV1alpha1Client) Machines(namespace string) MachineInterface {
 	return newMachines(c, namespace)
 }
 
+func (c *LifecycleV1alpha1Client) MachineHistories(namespace string) MachineHistoryInterface {
+	return newMachineHistories(c, namespace)
+}
+
 func (c *LifecycleV1alpha1Client) MachineTypes(namespace string) MachineTypeInterface {
 	return newMachineTypes(c, namespace)
 }
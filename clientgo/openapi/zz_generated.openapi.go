@@ -18,18 +18,28 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.AvailablePackageVersions": schema_lifecycle_manager_api_lifecycle_v1alpha1_AvailablePackageVersions(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.Machine":                  schema_lifecycle_manager_api_lifecycle_v1alpha1_Machine(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroup":             schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineGroup(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineList":              schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineList(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineSpec":              schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineSpec(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineStatus":            schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineStatus(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineType":              schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineType(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineTypeList":          schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeList(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineTypeSpec":          schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeSpec(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineTypeStatus":        schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeStatus(ref),
-		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion":           schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageVersion(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                       schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.AvailablePackageVersions":  schema_lifecycle_manager_api_lifecycle_v1alpha1_AvailablePackageVersions(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.DesiredPackageVersion":     schema_lifecycle_manager_api_lifecycle_v1alpha1_DesiredPackageVersion(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.FirmwareChange":            schema_lifecycle_manager_api_lifecycle_v1alpha1_FirmwareChange(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.InstallApproval":           schema_lifecycle_manager_api_lifecycle_v1alpha1_InstallApproval(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.Machine":                   schema_lifecycle_manager_api_lifecycle_v1alpha1_Machine(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroup":              schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineGroup(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroupRolloutStatus": schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineGroupRolloutStatus(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineHistory":            schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineHistory(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineHistoryList":        schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineHistoryList(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineList":               schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineList(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineSpec":               schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineSpec(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineStatus":             schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineStatus(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineType":               schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineType(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineTypeList":           schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeList(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineTypeSpec":           schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeSpec(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineTypeStatus":         schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeStatus(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MaintenanceWindow":         schema_lifecycle_manager_api_lifecycle_v1alpha1_MaintenanceWindow(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageDependency":         schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageDependency(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageInstallStatus":      schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageInstallStatus(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion":            schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageVersion(ref),
+		"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.RolloutStrategy":           schema_lifecycle_manager_api_lifecycle_v1alpha1_RolloutStrategy(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                        schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
 		"k8s.io/api/core/v1.AvoidPods":                                   schema_k8sio_api_core_v1_AvoidPods(ref),
@@ -337,10 +347,217 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_AvailablePackageVersions(re
 							},
 						},
 					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies reflects packages, which must be installed before any version of the package, e.g. BMC firmware required by BIOS.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageDependency"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "versions"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageDependency"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_DesiredPackageVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DesiredPackageVersion defines the effective package version along with its origin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines the name of the firmware package.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the version of the firmware package.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source defines where the version comes from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineGroup defines the name of machine group the version comes from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"constraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraint defines the version constraint the version is resolved from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "version", "source"},
+			},
+		},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_FirmwareChange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FirmwareChange reflects the change of installed version of the package.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time reflects when the change was recorded.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"package": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Package reflects the name of the package.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"oldVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OldVersion reflects the version installed before the change, empty if the package was not installed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewVersion reflects the version installed after the change, empty if the package was removed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source reflects whether the change was observed by the scan or made by installation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID reflects the job which reported the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"initiator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Initiator reflects the user who requested the job, if known.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"time", "package", "source"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_InstallApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstallApproval defines the request to approve installation of pending packages and the decision made on it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision identifies pending packages the approval applies to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"packages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packages reflects the package versions pending installation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion"),
+									},
+								},
+							},
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State reflects whether the installation is approved.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestTime reflects when the approval was requested.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User reflects who approved or rejected the installation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"decisionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DecisionTime reflects when the installation was approved or rejected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason explains the decision.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"revision", "state"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -400,39 +617,278 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineGroup(ref common.Ref
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name defines machine group name",
-							Default:     "",
+							Description: "Name defines machine group name",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineSelector defines native kubernetes label selector to apply to Machine objects.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"packages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packages defines default firmware package versions for the group of Machine objects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion"),
+									},
+								},
+							},
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority defines precedence of the group if machine matches several groups. Group with higher value takes precedence.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"downgradePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowngradePolicy overrides downgrade policy of the machine type for machines of the group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout defines how changes of group packages are rolled out to machines of the group. If not set, all machines install changes at once. Applies to named groups only.",
+							Ref:         ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.RolloutStrategy"),
+						},
+					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows defines names of maintenance windows of the machine type, which apply to machines of the group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval defines whether installation of packages on machines of the group must be approved. Defaults to None.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines whether machines of the group, which firmware was changed out of band, are re-converged to the desired state or only reported. Defaults to Reconverge.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "machineSelector", "packages"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion", "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.RolloutStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineGroupRolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineGroupRolloutStatus defines the progress of machine group rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision identifies packages of the group being rolled out. Machines admitted to install them are annotated with the revision.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the rollout.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Batch is the number of started batches.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machines is the number of machines in the group.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Updated is the number of machines having group packages installed.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"installing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Installing is the number of admitted machines installing packages.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed is the number of admitted machines failed to install packages.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the phase changed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains the current phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "revision", "batch", "machines", "updated", "installing", "failed"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHistory is the Schema for the machinehistories API. It records changes of firmware of the Machine with the same name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"changes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changes reflects the latest changes of installed firmware, the most recent one is the last.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.FirmwareChange"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.FirmwareChange", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineHistoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHistoryList contains a list of MachineHistory.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineSelector": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineSelector defines native kubernetes label selector to apply to Machine objects.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"packages": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Packages defines default firmware package versions for the group of Machine objects.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion"),
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineHistory"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name", "machineSelector", "packages"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineHistory", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
@@ -572,6 +1028,20 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineStatus(ref common.Re
 							},
 						},
 					},
+					"desiredPackages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredPackages reflects the effective versions of firmware packages resolved from machine's spec and defaults of the machine group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.DesiredPackageVersion"),
+									},
+								},
+							},
+						},
+					},
 					"message": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Message contains verbose message explaining current state",
@@ -594,12 +1064,46 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineStatus(ref common.Re
 							},
 						},
 					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval reflects the approval of the pending installation, if the machine group requires it.",
+							Ref:         ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.InstallApproval"),
+						},
+					},
+					"approvalHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovalHistory reflects the latest decisions on installations of the machine, the most recent one is the last.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.InstallApproval"),
+									},
+								},
+							},
+						},
+					},
+					"packageStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PackageStatuses reflects the progress of installation of packages, one entry per package.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageInstallStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"lastScanTime", "lastScanResult", "installedPackages", "message", "conditions"},
+				Required: []string{"lastScanTime", "lastScanResult", "installedPackages", "desiredPackages", "message", "conditions"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.DesiredPackageVersion", "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.InstallApproval", "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageInstallStatus", "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.PackageVersion", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -743,12 +1247,33 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeSpec(ref common.
 							},
 						},
 					},
+					"downgradePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowngradePolicy defines whether package versions lower than installed ones might be installed. Defaults to Deny.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenanceWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceWindows defines periods of time, when packages might be installed. Windows apply to machines of groups referring to them and to machines matching their selectors. Machines, which no window applies to, might be installed at any time.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MaintenanceWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"manufacturer", "type", "scanPeriod", "machineGroups"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroup", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroup", "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MaintenanceWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -796,12 +1321,200 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_MachineTypeStatus(ref commo
 							Format:      "",
 						},
 					},
+					"rollouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollouts reflects the progress of rollouts of machine groups having rollout strategy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroupRolloutStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions reflects MachineType conditions and their state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"lastScanTime", "lastScanResult", "availablePackages", "message"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.AvailablePackageVersions", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.AvailablePackageVersions", "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1.MachineGroupRolloutStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_MaintenanceWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceWindow defines recurring period of time, when disruptive work like installation of packages is allowed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines maintenance window name.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule defines start of the window in cron format, e.g. \"0 22 * * 1-5\" starts the window at 22:00 on weekdays.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration defines how long the window lasts.",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone defines the time zone of the schedule, e.g. \"Europe/Berlin\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineSelector selects machines the window applies to regardless of their machine group.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"name", "schedule", "duration"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PackageDependency defines the package required by another package.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name defines the name of the required package.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version defines the required version either as exact version or as constraint expression, e.g. \">=1.5.0\". Any version satisfies empty one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageInstallStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PackageInstallStatus reflects the progress of installation of the package version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name reflects the name of the package.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version reflects the version of the package being installed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State reflects the state of installation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts reflects the number of attempts to install the version.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError reflects the error of the last failed attempt.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JobID reflects the job which performed the last attempt.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime reflects when the last attempt started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime reflects when the last attempt either succeeded or failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime reflects when the state changed last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "version", "state"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -822,7 +1535,7 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageVersion(ref common.R
 					},
 					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version defines the version of the firmware package.",
+							Description: "Version defines the version of the firmware package. Besides exact version it might be a constraint expression like \">=2.8.0 <3.0.0\", \"~2.8\" or \"latest\", which is resolved against available versions.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -835,6 +1548,47 @@ func schema_lifecycle_manager_api_lifecycle_v1alpha1_PackageVersion(ref common.R
 	}
 }
 
+func schema_lifecycle_manager_api_lifecycle_v1alpha1_RolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RolloutStrategy defines how changes of machine group packages are rolled out. Machines are admitted to install changes in batches, the next batch is started once the previous one is finished and the pause is over.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable defines the maximum number of machines installing packages at once, either absolute number or percentage of machines in the group. Defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"canaryBatchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanaryBatchSize defines the number of machines in the first batch. If not set, the first batch is limited by MaxUnavailable.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pauseBetweenBatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseBetweenBatches defines the pause between the end of the batch and the start of the next one.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxFailurePercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFailurePercentage defines the percentage of failed installations among admitted machines, exceeding which halts the rollout. Defaults to 0, so the first failure halts the rollout.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"io"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/admin/v1alpha1/adminv1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machinetype/v1alpha1/machinetypev1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/storage/v1alpha1/commonv1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+)
+
+type Options struct {
+	configPath string
+	endpoint   string
+	storage    string
+	namespace  string
+	output     string
+	token      string
+	tokenFile  string
+	caFile     string
+	certFile   string
+	keyFile    string
+	insecure   bool
+
+	// config is the effective configuration, which is populated before
+	// any subcommand runs.
+	config *Config
+	out    io.Writer
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.configPath, "config", defaultConfigPath(),
+		"path to config file, might be set with "+configEnv+" environment variable")
+	fs.StringVar(&o.endpoint, "endpoint", defaultAddress, "lifecycle-service endpoint")
+	fs.StringVar(&o.storage, "storage-endpoint", "", "firmware storage service endpoint (default --endpoint)")
+	fs.StringVarP(&o.namespace, "namespace", "n", "",
+		"namespace of objects (default lifecycle-service's namespace)")
+	fs.StringVarP(&o.output, "output", "o", tableFormat, "output format, one of: table, json, yaml")
+	fs.StringVar(&o.token, "token", "", "bearer token for authorization")
+	fs.StringVar(&o.tokenFile, "token-file", "", "path to file containing bearer token")
+	fs.StringVar(&o.caFile, "ca-file", "", "path to CA certificates file to verify lifecycle-service")
+	fs.StringVar(&o.certFile, "cert-file", "", "path to client certificate file")
+	fs.StringVar(&o.keyFile, "key-file", "", "path to client key file")
+	fs.BoolVar(&o.insecure, "insecure-skip-tls-verify", false,
+		"skip verification of lifecycle-service certificate")
+}
+
+// complete merges config file with command-line flags. Flags take
+// precedence over config file, which takes precedence over defaults.
+func (o *Options) complete(fs *pflag.FlagSet) error {
+	cfg, err := loadConfig(o.configPath, fs.Changed("config"))
+	if err != nil {
+		return err
+	}
+	override := func(flag string, dst *string, value string) {
+		if fs.Changed(flag) || *dst == "" {
+			*dst = value
+		}
+	}
+	override("endpoint", &cfg.Endpoint, o.endpoint)
+	override("storage-endpoint", &cfg.StorageEndpoint, o.storage)
+	if cfg.StorageEndpoint == "" {
+		cfg.StorageEndpoint = cfg.Endpoint
+	}
+	override("namespace", &cfg.Namespace, o.namespace)
+	override("token", &cfg.Token, o.token)
+	override("token-file", &cfg.TokenFile, o.tokenFile)
+	override("ca-file", &cfg.TLS.CAFile, o.caFile)
+	override("cert-file", &cfg.TLS.CertFile, o.certFile)
+	override("key-file", &cfg.TLS.KeyFile, o.keyFile)
+	if fs.Changed("insecure-skip-tls-verify") {
+		cfg.TLS.InsecureSkipVerify = o.insecure
+	}
+	o.config = cfg
+	return nil
+}
+
+func (o *Options) printer() (*printer, error) {
+	return newPrinter(o.output, o.out)
+}
+
+func (o *Options) clientOptions(endpoint string) ([]connect.ClientOption, *http.Client, error) {
+	httpClient, err := newHTTPClient(o.config, endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokenInterceptor := interceptor.NewTokenInterceptor(&rest.Config{
+		BearerToken:     o.config.Token,
+		BearerTokenFile: o.config.TokenFile,
+	})
+	return []connect.ClientOption{connect.WithGRPC(), connect.WithInterceptors(tokenInterceptor)}, httpClient, nil
+}
+
+func (o *Options) machineClient() (machinev1alpha1connect.MachineServiceClient, error) {
+	opts, httpClient, err := o.clientOptions(o.config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return machinev1alpha1connect.NewMachineServiceClient(httpClient, o.config.Endpoint, opts...), nil
+}
+
+func (o *Options) machineTypeClient() (machinetypev1alpha1connect.MachineTypeServiceClient, error) {
+	opts, httpClient, err := o.clientOptions(o.config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return machinetypev1alpha1connect.NewMachineTypeServiceClient(httpClient, o.config.Endpoint, opts...), nil
+}
+
+func (o *Options) adminClient() (adminv1alpha1connect.AdminServiceClient, error) {
+	opts, httpClient, err := o.clientOptions(o.config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return adminv1alpha1connect.NewAdminServiceClient(httpClient, o.config.Endpoint, opts...), nil
+}
+
+func (o *Options) storageClient() (commonv1alpha1connect.FirmwareStorageServiceClient, error) {
+	opts, httpClient, err := o.clientOptions(o.config.StorageEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	return commonv1alpha1connect.NewFirmwareStorageServiceClient(httpClient, o.config.StorageEndpoint, opts...), nil
+}
+
+func Command() *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:           "lcmctl",
+		Short:         "lcmctl controls firmware lifecycle of machines via lifecycle-service",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			opts.out = cmd.OutOrStdout()
+			return opts.complete(cmd.Flags())
+		},
+	}
+	opts.addFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(
+		machineCommand(opts),
+		machineTypeCommand(opts),
+		firmwareCommand(opts),
+		planCommand(opts),
+		schedulingCommand(opts),
+	)
+	return cmd
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"connectrpc.com/connect"
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/admin/v1alpha1/adminv1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machinetype/v1alpha1/machinetypev1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle/fake"
+	adminsvc "github.com/ironcore-dev/lifecycle-manager/internal/service/admin/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
+	machinesvc "github.com/ironcore-dev/lifecycle-manager/internal/service/machine/v1alpha1"
+	machinetypesvc "github.com/ironcore-dev/lifecycle-manager/internal/service/machinetype/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/approvalutil"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/encoding/protojson"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("lcmctl", func() {
+	var (
+		clientset *fake.Clientset
+		server    *httptest.Server
+		headers   chan http.Header
+	)
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset(
+			&lifecyclev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "metal", Labels: map[string]string{"rack": "r1"}},
+				Spec: lifecyclev1alpha1.MachineSpec{
+					MachineTypeRef: corev1.LocalObjectReference{Name: "type-a"},
+					Packages:       []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+				},
+			},
+			&lifecyclev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-2", Namespace: "metal", Labels: map[string]string{"rack": "r2"}},
+				Spec: lifecyclev1alpha1.MachineSpec{
+					MachineTypeRef: corev1.LocalObjectReference{Name: "type-a"},
+				},
+			},
+			&lifecyclev1alpha1.MachineType{
+				ObjectMeta: metav1.ObjectMeta{Name: "type-a", Namespace: "metal"},
+				Spec:       lifecyclev1alpha1.MachineTypeSpec{Manufacturer: "Sample", Type: "Server"},
+			},
+		)
+		logger := interceptor.NewLoggerInterceptor(slog.New(slog.NewTextHandler(GinkgoWriter, nil)))
+		mux := http.NewServeMux()
+		mux.Handle(machinev1alpha1connect.NewMachineServiceHandler(
+			machinesvc.NewService(nil, machinesvc.WithClientset(clientset), machinesvc.WithNamespace("metal")),
+			connect.WithInterceptors(logger)))
+		mux.Handle(machinetypev1alpha1connect.NewMachineTypeServiceHandler(
+			machinetypesvc.NewService(nil, machinetypesvc.WithClientset(clientset), machinetypesvc.WithNamespace("metal")),
+			connect.WithInterceptors(logger)))
+		mux.Handle(adminv1alpha1connect.NewAdminServiceHandler(adminsvc.NewService(),
+			connect.WithInterceptors(logger)))
+		headers = make(chan http.Header, 16)
+		server = httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers <- r.Header.Clone()
+			mux.ServeHTTP(w, r)
+		}), &http2.Server{}))
+		DeferCleanup(server.Close)
+	})
+
+	run := func(args ...string) (string, error) {
+		out := &bytes.Buffer{}
+		cmd := Command()
+		cmd.SetOut(out)
+		cmd.SetArgs(append([]string{"--config=", "--endpoint=" + server.URL}, args...))
+		err := cmd.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	It("Should list machines as table", func() {
+		out, err := run("machine", "list", "-l", "rack=r1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("NAME"))
+		Expect(out).To(MatchRegexp(`machine-1\s+type-a\s+<none>\s+UNSPECIFIED\s+bios=1.0.0\s+<none>`))
+		Expect(out).NotTo(ContainSubstring("machine-2"))
+	})
+
+	It("Should list machines as JSON", func() {
+		out, err := run("machine", "list", "-o", "json", "--chunk-size", "1")
+		Expect(err).NotTo(HaveOccurred())
+		resp := &machinev1alpha1.ListMachinesResponse{}
+		Expect(protojson.Unmarshal([]byte(out), resp)).To(Succeed())
+		Expect(resp.Machines).To(HaveLen(2))
+	})
+
+	It("Should print package changes in dry run mode", func() {
+		out, err := run("machine", "packages", "set", "bios=2.0.0", "--machine-type", "type-a", "--dry-run", "-o", "yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("name: machine-2"))
+		Expect(out).To(ContainSubstring("version: 2.0.0"))
+
+		machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, "machine-1", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machine.Spec.Packages[0].Version).To(Equal("1.0.0"))
+	})
+
+	It("Should set package version of the machine", func() {
+		out, err := run("machine", "packages", "set", "machine-1", "bios=2.0.0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`machine-1\s+SUCCESS`))
+
+		machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, "machine-1", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machine.Spec.Packages[0].Version).To(Equal("2.0.0"))
+	})
+
+	It("Should add machine group to machine type", func() {
+		_, err := run("machinetype", "groups", "add", "type-a", "canary",
+			"--machine-selector", "rack=r2", "--package", "bios=2.0.0")
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := run("machinetype", "list")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`type-a\s+Sample\s+Server\s+canary`))
+	})
+
+	It("Should approve pending installation of the machine", func() {
+		machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, "machine-2", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		approvalutil.Request(machine, []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}, time.Now())
+		_, err = clientset.LifecycleV1alpha1().Machines("metal").UpdateStatus(ctx, machine, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := run("machine", "approve", "machine-2", "--approver", "alice", "--reason", "tested")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`machine-2\s+SUCCESS\s+[0-9a-f]{8}\s+Approved\s+alice\s+tested`))
+
+		out, err = run("machine", "reject", "machine-2", "--approver", "bob")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`machine-2\s+FAILURE\s+[0-9a-f]{8}\s+Approved\s+alice\s+installation is already`))
+	})
+
+	It("Should pause and resume scheduling", func() {
+		out, err := run("scheduling", "pause", "--reason", "storage maintenance")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`true\s+<none>\s+storage maintenance\s+\d{4}-`))
+
+		out, err = run("scheduling", "status")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`true\s+<none>\s+storage maintenance`))
+
+		out, err = run("scheduling", "resume")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`false\s+<none>\s+<none>\s+<none>`))
+	})
+
+	It("Should read connection parameters from config file", func() {
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("secret\n"), 0o600)).To(Succeed())
+		config := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(config, []byte("endpoint: "+server.URL+"\nnamespace: other\ntokenFile: "+tokenFile+"\n"),
+			0o600)).To(Succeed())
+
+		cmd := Command()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"--config", config, "-n", "metal", "machine", "list"})
+		Expect(cmd.ExecuteContext(ctx)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("machine-1"))
+		Expect((<-headers).Get("Authorization")).To(Equal("Bearer secret"))
+	})
+
+	It("Should show install plan", func() {
+		_, err := clientset.LifecycleV1alpha1().Machines("metal").UpdateStatus(ctx, &lifecyclev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "metal", Labels: map[string]string{"rack": "r1"}},
+			Spec: lifecyclev1alpha1.MachineSpec{
+				MachineTypeRef: corev1.LocalObjectReference{Name: "type-a"},
+				Packages:       []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+			},
+			Status: lifecyclev1alpha1.MachineStatus{
+				InstalledPackages: []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "0.9.0"}},
+			},
+		}, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := run("plan", "--machine-type", "type-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`machine-1\s+bios\s+1.0.0\s+machine\s+0.9.0\s+yes`))
+		Expect(out).NotTo(ContainSubstring("machine-2"))
+	})
+
+	It("Should show firmware history of the machine", func() {
+		_, err := clientset.LifecycleV1alpha1().MachineHistories("metal").Create(ctx, &lifecyclev1alpha1.MachineHistory{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "metal"},
+			Changes: []lifecyclev1alpha1.FirmwareChange{
+				{Time: metav1.Now(), Package: "bmc", NewVersion: "3.0.0", Source: lifecyclev1alpha1.FirmwareChangeSourceScan},
+				{Time: metav1.Now(), Package: "bios", OldVersion: "0.9.0", NewVersion: "1.0.0",
+					Source: lifecyclev1alpha1.FirmwareChangeSourceInstall, JobID: "a", Initiator: "alice"},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		out, err := run("machine", "history", "machine-1", "--package", "bios")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`\d{4}-\S+\s+bios\s+0.9.0\s+1.0.0\s+Install\s+a\s+alice`))
+		Expect(out).NotTo(ContainSubstring("bmc"))
+	})
+
+	It("Should fail on unsupported output format", func() {
+		_, err := run("machine", "list", "-o", "xml")
+		Expect(err).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+})
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"connectrpc.com/connect"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func machineApproveCommand(opts *Options) *cobra.Command {
+	return machineDecideCommand(opts, "approve", "Approve pending installation of firmware on the machine", false)
+}
+
+func machineRejectCommand(opts *Options) *cobra.Command {
+	return machineDecideCommand(opts, "reject", "Reject pending installation of firmware on the machine", true)
+}
+
+func machineDecideCommand(opts *Options, use, short string, reject bool) *cobra.Command {
+	var revision, reason, approver string
+	cmd := &cobra.Command{
+		Use:   use + " MACHINE",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.ApproveInstall(cmd.Context(), connect.NewRequest(&machinev1alpha1.ApproveInstallRequest{
+				Name:      args[0],
+				Namespace: opts.config.Namespace,
+				Revision:  revision,
+				Reject:    reject,
+				Reason:    reason,
+				Approver:  approver,
+			}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, approvalTable(args[0], resp.Msg))
+		},
+	}
+	cmd.Flags().StringVar(&revision, "revision", "",
+		"revision of pending packages the decision applies to, any pending revision if empty")
+	cmd.Flags().StringVar(&reason, "reason", "", "reason of the decision recorded in machine's status")
+	cmd.Flags().StringVar(&approver, "approver", "",
+		"user recorded as approver, ignored if lifecycle-service authenticates the caller")
+	return cmd
+}
+
+func approvalTable(name string, resp *machinev1alpha1.ApproveInstallResponse) *table {
+	t := &table{header: []string{"NAME", "RESULT", "REVISION", "STATE", "USER", "REASON"}}
+	approval := resp.GetApproval()
+	reason := resp.GetReason()
+	if reason == "" {
+		reason = approval.GetReason()
+	}
+	t.append(name, requestResult(resp.GetResult()), orNone(approval.GetRevision()),
+		orNone(approval.GetState()), orNone(approval.GetUser()), orNone(reason))
+	return t
+}
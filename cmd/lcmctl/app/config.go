@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/net/http2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configEnv      = "LCMCTL_CONFIG"
+	configDir      = "lcmctl"
+	configFile     = "config.yaml"
+	defaultAddress = "http://localhost:8080"
+)
+
+// Config defines connection parameters of lifecycle-service. It is read
+// from the config file, values passed with command-line flags take
+// precedence.
+type Config struct {
+	// Endpoint is the URL of lifecycle-service. TLS is used for https scheme.
+	Endpoint string `json:"endpoint,omitempty"`
+	// StorageEndpoint is the URL of firmware storage service. Endpoint is
+	// used when empty.
+	StorageEndpoint string `json:"storageEndpoint,omitempty"`
+	// Namespace is the default namespace of requests.
+	Namespace string `json:"namespace,omitempty"`
+	// Token is the bearer token sent with requests.
+	Token string `json:"token,omitempty"`
+	// TokenFile is the path to the file containing bearer token. It is
+	// preferred over Token when both are set.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// TLS defines TLS parameters for https endpoint.
+	TLS TLSConfig `json:"tls,omitempty"`
+}
+
+type TLSConfig struct {
+	// CAFile is the path to the file containing CA certificates used to
+	// verify the server. System pool is used when empty.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile and KeyFile are paths to the client certificate and key.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
+
+// defaultConfigPath returns the path of the config file, which is either
+// defined by LCMCTL_CONFIG environment variable or located in the user's
+// config directory.
+func defaultConfigPath() string {
+	if path := os.Getenv(configEnv); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, configDir, configFile)
+}
+
+// loadConfig reads config from the file. Missing file is not an error
+// unless it is required, which is the case for explicitly passed path.
+func loadConfig(path string, required bool) (*Config, error) {
+	cfg := &Config{}
+	if path == "" {
+		return cfg, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !required {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err = yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func newHTTPClient(cfg *Config, rawURL string) (*http.Client, error) {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	switch endpoint.Scheme {
+	case "http":
+		return &http.Client{
+			Transport: &http2.Transport{
+				AllowHTTP: true,
+				DialTLSContext: func(_ context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+					return net.Dial(network, addr)
+				},
+			},
+		}, nil
+	case "https":
+		tlsConfig, err := cfg.TLS.clientConfig()
+		if err != nil {
+			return nil, err
+		}
+		return &http.Client{Transport: &http2.Transport{TLSClientConfig: tlsConfig}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported endpoint scheme %q", endpoint.Scheme)
+	}
+}
+
+func (c TLSConfig) clientConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		data, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
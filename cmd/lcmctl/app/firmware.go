@@ -0,0 +1,415 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"connectrpc.com/connect"
+	storagev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/storage/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/storage/v1alpha1/commonv1alpha1connect"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const defaultChunkSize = 1 << 20
+
+// metadataOptions identify the firmware package in the storage.
+type metadataOptions struct {
+	manufacturer string
+	machineType  string
+	pkg          string
+	version      string
+}
+
+func (o *metadataOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.manufacturer, "manufacturer", "", "manufacturer of machines the package is intended for")
+	fs.StringVar(&o.machineType, "type", "", "type of machines the package is intended for")
+	fs.StringVar(&o.pkg, "package", "", "name of the package")
+	fs.StringVar(&o.version, "version", "", "version of the package")
+}
+
+func (o *metadataOptions) markRequired(cmd *cobra.Command) {
+	for _, flag := range []string{"manufacturer", "type", "package", "version"} {
+		_ = cmd.MarkFlagRequired(flag)
+	}
+}
+
+func (o *metadataOptions) metadata() *storagev1alpha1.Metadata {
+	return &storagev1alpha1.Metadata{
+		Manufacturer: o.manufacturer,
+		Type:         o.machineType,
+		Package:      o.pkg,
+		Version:      o.version,
+	}
+}
+
+// transferOptions define how the file is transferred.
+type transferOptions struct {
+	chunkSize int
+	retries   int
+	quiet     bool
+}
+
+func (o *transferOptions) addFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&o.retries, "retries", 3, "number of attempts to retry interrupted transfer")
+	fs.BoolVarP(&o.quiet, "quiet", "q", false, "do not show progress")
+}
+
+// addChunkSizeFlag registers the size of chunks, which is chosen by the
+// client on upload only. Storage chooses the size of downloaded chunks.
+func (o *transferOptions) addChunkSizeFlag(fs *pflag.FlagSet) {
+	fs.IntVar(&o.chunkSize, "chunk-size", defaultChunkSize, "size of transferred chunks in bytes")
+}
+
+func (o *transferOptions) progress(cmd *cobra.Command, total int64) *progressBar {
+	if o.quiet {
+		return newProgressBar(io.Discard, total)
+	}
+	return newProgressBar(cmd.ErrOrStderr(), total)
+}
+
+func firmwareCommand(opts *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "firmware",
+		Short: "Manage firmware packages in the storage",
+	}
+	cmd.AddCommand(
+		firmwareUploadCommand(opts),
+		firmwareDownloadCommand(opts),
+		firmwareListCommand(opts),
+	)
+	return cmd
+}
+
+func firmwareUploadCommand(opts *Options) *cobra.Command {
+	var (
+		metadata metadataOptions
+		transfer transferOptions
+	)
+	cmd := &cobra.Command{
+		Use:   "upload FILE",
+		Short: "Upload firmware package to the storage",
+		Long: "Upload firmware package to the storage. The file is transferred in numbered chunks, " +
+			"interrupted upload is resumed from the last chunk received by the storage.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			if transfer.chunkSize <= 0 {
+				return errors.New("--chunk-size must be positive")
+			}
+			client, err := opts.storageClient()
+			if err != nil {
+				return err
+			}
+			data, err := uploadFirmware(cmd, client, args[0], metadata.metadata(), &transfer)
+			if err != nil {
+				return err
+			}
+			return p.print(data, packageDataTable(data))
+		},
+	}
+	metadata.addFlags(cmd.Flags())
+	metadata.markRequired(cmd)
+	transfer.addFlags(cmd.Flags())
+	transfer.addChunkSizeFlag(cmd.Flags())
+	return cmd
+}
+
+func uploadFirmware(
+	cmd *cobra.Command,
+	client commonv1alpha1connect.FirmwareStorageServiceClient,
+	path string,
+	metadata *storagev1alpha1.Metadata,
+	transfer *transferOptions,
+) (*storagev1alpha1.PackageData, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute checksum: %w", err)
+	}
+	data := &storagev1alpha1.PackageData{
+		Metadata: metadata,
+		Filename: filepath.Base(path),
+		Checksum: hex.EncodeToString(hash.Sum(nil)),
+		Size:     size,
+	}
+
+	bar := transfer.progress(cmd, size)
+	defer bar.finish()
+	for attempt := 0; ; attempt++ {
+		err = uploadParts(cmd.Context(), client, f, data, transfer.chunkSize, bar)
+		if err == nil || attempt >= transfer.retries || !retryable(cmd.Context(), err) {
+			return data, err
+		}
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "\nupload interrupted, resuming: %s\n", err)
+	}
+}
+
+// uploadParts initializes upload and sends chunks of the file in order of
+// their numbers, starting from the part storage expects next. Storage
+// returns the same upload id and progress for the package with the same
+// checksum, which makes resumption possible. Progress includes the byte
+// offset, since chunk size of the interrupted upload might differ.
+func uploadParts(
+	ctx context.Context,
+	client commonv1alpha1connect.FirmwareStorageServiceClient,
+	f io.ReadSeeker,
+	data *storagev1alpha1.PackageData,
+	chunkSize int,
+	bar *progressBar,
+) error {
+	initResp, err := client.InitUpload(ctx, connect.NewRequest(&storagev1alpha1.InitUploadRequest{PackageData: data}))
+	if err != nil {
+		return err
+	}
+	part, offset := initResp.Msg.NextPart, initResp.Msg.NextOffset
+	if part > 0 && offset == 0 {
+		return connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("storage did not report offset of interrupted upload"))
+	}
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	bar.set(offset)
+
+	stream := client.Upload(ctx)
+	chunk := make([]byte, chunkSize)
+	for {
+		n, readErr := io.ReadFull(f, chunk)
+		if n > 0 {
+			req := &storagev1alpha1.UploadRequest{Id: initResp.Msg.Id, Part: part, Chunk: chunk[:n]}
+			if err = stream.Send(req); err != nil {
+				// actual error is returned on receive
+				if _, err = stream.CloseAndReceive(); err == nil {
+					err = errors.New("upload stream closed by storage")
+				}
+				return err
+			}
+			bar.add(int64(n))
+			part++
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+	resp, err := stream.CloseAndReceive()
+	if err != nil {
+		return err
+	}
+	if resp.Msg.Status != storagev1alpha1.TransferStatus_TRANSFER_STATUS_OK {
+		return fmt.Errorf("upload failed with status %s", resp.Msg.Status)
+	}
+	return nil
+}
+
+// retryable reports whether interrupted transfer makes sense to resume.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument, connect.CodeUnauthenticated, connect.CodePermissionDenied,
+		connect.CodeFailedPrecondition, connect.CodeNotFound, connect.CodeUnimplemented:
+		return false
+	default:
+		return true
+	}
+}
+
+func firmwareDownloadCommand(opts *Options) *cobra.Command {
+	var (
+		metadata metadataOptions
+		transfer transferOptions
+		file     string
+	)
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download firmware package from the storage",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.storageClient()
+			if err != nil {
+				return err
+			}
+			data, err := downloadFirmware(cmd, client, metadata.metadata(), file, &transfer)
+			if err != nil {
+				return err
+			}
+			return p.print(data, packageDataTable(data))
+		},
+	}
+	metadata.addFlags(cmd.Flags())
+	metadata.markRequired(cmd)
+	transfer.addFlags(cmd.Flags())
+	cmd.Flags().StringVarP(&file, "file", "f", "", "destination file (default package's file name)")
+	return cmd
+}
+
+func downloadFirmware(
+	cmd *cobra.Command,
+	client commonv1alpha1connect.FirmwareStorageServiceClient,
+	metadata *storagev1alpha1.Metadata,
+	file string,
+	transfer *transferOptions,
+) (*storagev1alpha1.PackageData, error) {
+	initResp, err := client.InitDownload(cmd.Context(),
+		connect.NewRequest(&storagev1alpha1.InitDownloadRequest{Metadata: metadata}))
+	if err != nil {
+		return nil, err
+	}
+	data := initResp.Msg.PackageData
+	if file == "" {
+		file = filepath.Base(data.GetFilename())
+	}
+	// package is written to temporary file, which is renamed only after
+	// checksum is verified
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.part")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	bar := transfer.progress(cmd, data.GetSize())
+	defer bar.finish()
+	// storage streams the package from the beginning, so interrupted download
+	// is restarted rather than resumed
+	for attempt := 0; ; attempt++ {
+		if err = restart(tmp, bar); err != nil {
+			return nil, err
+		}
+		err = downloadParts(cmd.Context(), client, initResp.Msg.Id, tmp, data.GetChecksum(), bar)
+		if err == nil {
+			break
+		}
+		if attempt >= transfer.retries || !retryable(cmd.Context(), err) {
+			return nil, err
+		}
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "\ndownload interrupted, restarting: %s\n", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return nil, err
+	}
+	return data, os.Rename(tmp.Name(), file)
+}
+
+// restart discards the content of partially downloaded file.
+func restart(f *os.File, bar *progressBar) error {
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	bar.set(0)
+	return nil
+}
+
+func downloadParts(
+	ctx context.Context,
+	client commonv1alpha1connect.FirmwareStorageServiceClient,
+	id string,
+	w io.Writer,
+	checksum string,
+	bar *progressBar,
+) error {
+	stream, err := client.Download(ctx, connect.NewRequest(&storagev1alpha1.DownloadRequest{Id: id}))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+
+	hash := sha256.New()
+	w = io.MultiWriter(w, hash)
+	var part int64
+	for stream.Receive() {
+		msg := stream.Msg()
+		if msg.Part != part {
+			return fmt.Errorf("unexpected part %d, expected %d", msg.Part, part)
+		}
+		if _, err = w.Write(msg.Chunk); err != nil {
+			return err
+		}
+		bar.add(int64(len(msg.Chunk)))
+		part++
+	}
+	if err = stream.Err(); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); checksum != "" && actual != checksum {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", checksum, actual)
+	}
+	return nil
+}
+
+func firmwareListCommand(opts *Options) *cobra.Command {
+	var metadata metadataOptions
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   "List firmware packages in the storage",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.storageClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListPackages(cmd.Context(),
+				connect.NewRequest(&storagev1alpha1.ListPackagesRequest{Filter: metadata.metadata()}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, packageDataTable(resp.Msg.Packages...))
+		},
+	}
+	metadata.addFlags(cmd.Flags())
+	return cmd
+}
+
+func packageDataTable(packages ...*storagev1alpha1.PackageData) *table {
+	t := &table{header: []string{"MANUFACTURER", "TYPE", "PACKAGE", "VERSION", "FILENAME", "SIZE", "CHECKSUM"}}
+	for _, data := range packages {
+		t.append(
+			orNone(data.GetMetadata().GetManufacturer()),
+			orNone(data.GetMetadata().GetType()),
+			orNone(data.GetMetadata().GetPackage()),
+			orNone(data.GetMetadata().GetVersion()),
+			orNone(data.GetFilename()),
+			strconv.FormatInt(data.GetSize(), 10),
+			orNone(data.GetChecksum()),
+		)
+	}
+	return t
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"connectrpc.com/connect"
+	storagev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/storage/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/storage/v1alpha1/commonv1alpha1connect"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+)
+
+// memoryStorage is an in-memory firmware storage, which keeps chunks of
+// uploads and might interrupt the upload stream after configured number
+// of parts.
+type memoryStorage struct {
+	commonv1alpha1connect.UnimplementedFirmwareStorageServiceHandler
+
+	mu        sync.Mutex
+	uploads   map[string]*upload
+	failAfter int
+}
+
+type upload struct {
+	data  *storagev1alpha1.PackageData
+	parts [][]byte
+}
+
+func (u *upload) content() []byte {
+	return bytes.Join(u.parts, nil)
+}
+
+func (s *memoryStorage) InitUpload(
+	_ context.Context,
+	req *connect.Request[storagev1alpha1.InitUploadRequest],
+) (*connect.Response[storagev1alpha1.InitUploadResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := req.Msg.PackageData.Checksum
+	u, ok := s.uploads[id]
+	if !ok {
+		u = &upload{data: req.Msg.PackageData}
+		s.uploads[id] = u
+	}
+	return connect.NewResponse(&storagev1alpha1.InitUploadResponse{
+		Id:         id,
+		NextPart:   int64(len(u.parts)),
+		NextOffset: int64(len(u.content())),
+	}), nil
+}
+
+func (s *memoryStorage) Upload(
+	_ context.Context,
+	stream *connect.ClientStream[storagev1alpha1.UploadRequest],
+) (*connect.Response[storagev1alpha1.UploadResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var u *upload
+	for stream.Receive() {
+		if s.failAfter == 0 {
+			s.failAfter = -1
+			return nil, connect.NewError(connect.CodeUnavailable, errors.New("connection lost"))
+		}
+		s.failAfter--
+		u = s.uploads[stream.Msg().Id]
+		if stream.Msg().Part != int64(len(u.parts)) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unexpected part"))
+		}
+		u.parts = append(u.parts, stream.Msg().Chunk)
+	}
+	checksum := sha256.Sum256(u.content())
+	if hex.EncodeToString(checksum[:]) != u.data.Checksum {
+		return connect.NewResponse(&storagev1alpha1.UploadResponse{Status: storagev1alpha1.TransferStatus_TRANSFER_STATUS_FAILED}), nil
+	}
+	return connect.NewResponse(&storagev1alpha1.UploadResponse{Status: storagev1alpha1.TransferStatus_TRANSFER_STATUS_OK}), nil
+}
+
+func (s *memoryStorage) InitDownload(
+	_ context.Context,
+	req *connect.Request[storagev1alpha1.InitDownloadRequest],
+) (*connect.Response[storagev1alpha1.InitDownloadResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, u := range s.uploads {
+		if proto.Equal(u.data.Metadata, req.Msg.Metadata) {
+			return connect.NewResponse(&storagev1alpha1.InitDownloadResponse{PackageData: u.data, Id: id}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("package not found"))
+}
+
+func (s *memoryStorage) Download(
+	_ context.Context,
+	req *connect.Request[storagev1alpha1.DownloadRequest],
+	stream *connect.ServerStream[storagev1alpha1.DownloadResponse],
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for part, chunk := range s.uploads[req.Msg.Id].parts {
+		if s.failAfter == 0 {
+			s.failAfter = -1
+			return connect.NewError(connect.CodeUnavailable, errors.New("connection lost"))
+		}
+		s.failAfter--
+		if err := stream.Send(&storagev1alpha1.DownloadResponse{Id: req.Msg.Id, Part: int64(part), Chunk: chunk}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStorage) ListPackages(
+	_ context.Context,
+	req *connect.Request[storagev1alpha1.ListPackagesRequest],
+) (*connect.Response[storagev1alpha1.ListPackagesResponse], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &storagev1alpha1.ListPackagesResponse{}
+	for _, u := range s.uploads {
+		if filter := req.Msg.Filter.GetPackage(); filter != "" && filter != u.data.Metadata.Package {
+			continue
+		}
+		resp.Packages = append(resp.Packages, u.data)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+var _ = Describe("lcmctl firmware", func() {
+	var (
+		storage *memoryStorage
+		server  *httptest.Server
+		dir     string
+	)
+	content := []byte("firmware-package-content")
+	metadataArgs := []string{"--manufacturer", "Sample", "--type", "Server", "--package", "bios", "--version", "2.0.0"}
+
+	BeforeEach(func() {
+		storage = &memoryStorage{uploads: make(map[string]*upload), failAfter: -1}
+		mux := http.NewServeMux()
+		mux.Handle(commonv1alpha1connect.NewFirmwareStorageServiceHandler(storage))
+		server = httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+		DeferCleanup(server.Close)
+		dir = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "bios.bin"), content, 0o600)).To(Succeed())
+	})
+
+	run := func(args ...string) (string, string, error) {
+		out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+		cmd := Command()
+		cmd.SetOut(out)
+		cmd.SetErr(errOut)
+		cmd.SetArgs(append([]string{"--config=", "--storage-endpoint=" + server.URL}, args...))
+		err := cmd.ExecuteContext(context.Background())
+		return out.String(), errOut.String(), err
+	}
+
+	It("Should upload package and resume interrupted upload", func() {
+		storage.failAfter = 2
+		args := append([]string{"firmware", "upload", filepath.Join(dir, "bios.bin"), "--chunk-size", "5"}, metadataArgs...)
+		out, errOut, err := run(args...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errOut).To(ContainSubstring("upload interrupted, resuming"))
+		Expect(errOut).To(ContainSubstring("100%"))
+		Expect(out).To(MatchRegexp(`Sample\s+Server\s+bios\s+2.0.0\s+bios.bin\s+24`))
+
+		checksum := sha256.Sum256(content)
+		Expect(storage.uploads).To(HaveKey(hex.EncodeToString(checksum[:])))
+		Expect(storage.uploads[hex.EncodeToString(checksum[:])].content()).To(Equal(content))
+	})
+
+	It("Should resume upload interrupted with different chunk size", func() {
+		storage.failAfter = 2
+		args := append([]string{"firmware", "upload", filepath.Join(dir, "bios.bin"), "-q", "--retries", "0",
+			"--chunk-size", "5"}, metadataArgs...)
+		_, _, err := run(args...)
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeUnavailable))
+
+		args = append([]string{"firmware", "upload", filepath.Join(dir, "bios.bin"), "-q",
+			"--chunk-size", "7"}, metadataArgs...)
+		_, _, err = run(args...)
+		Expect(err).NotTo(HaveOccurred())
+
+		checksum := sha256.Sum256(content)
+		Expect(storage.uploads[hex.EncodeToString(checksum[:])].content()).To(Equal(content))
+	})
+
+	It("Should download uploaded package", func() {
+		args := append([]string{"firmware", "upload", filepath.Join(dir, "bios.bin"), "-q"}, metadataArgs...)
+		_, _, err := run(args...)
+		Expect(err).NotTo(HaveOccurred())
+
+		target := filepath.Join(dir, "downloaded.bin")
+		args = append([]string{"firmware", "download", "-q", "-f", target}, metadataArgs...)
+		_, _, err = run(args...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.ReadFile(target)).To(Equal(content))
+	})
+
+	It("Should restart interrupted download", func() {
+		args := append([]string{"firmware", "upload", filepath.Join(dir, "bios.bin"), "-q", "--chunk-size", "5"},
+			metadataArgs...)
+		_, _, err := run(args...)
+		Expect(err).NotTo(HaveOccurred())
+
+		storage.failAfter = 2
+		target := filepath.Join(dir, "downloaded.bin")
+		args = append([]string{"firmware", "download", "-f", target}, metadataArgs...)
+		_, errOut, err := run(args...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errOut).To(ContainSubstring("download interrupted, restarting"))
+		Expect(os.ReadFile(target)).To(Equal(content))
+	})
+
+	It("Should not accept chunk size on download", func() {
+		args := append([]string{"firmware", "download", "--chunk-size", "5"}, metadataArgs...)
+		_, _, err := run(args...)
+		Expect(err).To(MatchError(ContainSubstring("unknown flag")))
+	})
+
+	It("Should list packages", func() {
+		args := append([]string{"firmware", "upload", filepath.Join(dir, "bios.bin"), "-q"}, metadataArgs...)
+		_, _, err := run(args...)
+		Expect(err).NotTo(HaveOccurred())
+
+		out, _, err := run("firmware", "ls", "--package", "bios")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(MatchRegexp(`bios\s+2.0.0\s+bios.bin`))
+
+		out, _, err = run("firmware", "ls", "--package", "bmc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).NotTo(ContainSubstring("bios.bin"))
+	})
+
+	It("Should require package metadata", func() {
+		_, _, err := run("firmware", "upload", filepath.Join(dir, "bios.bin"))
+		Expect(err).To(MatchError(ContainSubstring("required flag")))
+	})
+})
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"connectrpc.com/connect"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func machineHistoryCommand(opts *Options) *cobra.Command {
+	var (
+		pkg   string
+		limit int32
+	)
+	cmd := &cobra.Command{
+		Use:   "history MACHINE",
+		Short: "Show changes of firmware installed on the machine",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			req := &machinev1alpha1.GetMachineHistoryRequest{
+				Name:      args[0],
+				Namespace: opts.config.Namespace,
+				Package:   pkg,
+				Limit:     limit,
+			}
+			resp, err := client.GetMachineHistory(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, historyTable(resp.Msg.Changes))
+		},
+	}
+	cmd.Flags().StringVar(&pkg, "package", "", "show only changes of the package")
+	cmd.Flags().Int32Var(&limit, "limit", 0, "show only the most recent changes, all recorded changes if 0")
+	return cmd
+}
+
+func historyTable(changes []*machinev1alpha1.FirmwareChange) *table {
+	t := &table{header: []string{"TIME", "PACKAGE", "OLD", "NEW", "SOURCE", "JOB", "INITIATOR"}}
+	for _, change := range changes {
+		t.append(timestamp(change.Time), change.Package, orNone(change.OldVersion), orNone(change.NewVersion),
+			change.Source, orNone(change.JobId), orNone(change.Initiator))
+	}
+	return t
+}
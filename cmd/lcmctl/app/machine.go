@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// selectorOptions define the set of machines bulk operations apply to.
+type selectorOptions struct {
+	labelSelector string
+	machineType   string
+	machineGroup  string
+}
+
+func (o *selectorOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.labelSelector, "selector", "l", "", "label selector of machines")
+	fs.StringVar(&o.machineType, "machine-type", "", "name of machine type machines refer to")
+	fs.StringVar(&o.machineGroup, "machine-group", "",
+		"name of machine group defined in machine type, requires --machine-type")
+}
+
+func (o *selectorOptions) isSet() bool {
+	return o.labelSelector != "" || o.machineType != "" || o.machineGroup != ""
+}
+
+func (o *selectorOptions) selector() (*metav1.LabelSelector, *machinev1alpha1.MachineGroupReference, error) {
+	var (
+		labelSelector *metav1.LabelSelector
+		ref           *machinev1alpha1.MachineGroupReference
+	)
+	if o.machineGroup != "" && o.machineType == "" {
+		return nil, nil, errors.New("--machine-group requires --machine-type")
+	}
+	if o.labelSelector != "" {
+		var err error
+		if labelSelector, err = metav1.ParseToLabelSelector(o.labelSelector); err != nil {
+			return nil, nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+	}
+	if o.machineType != "" {
+		ref = &machinev1alpha1.MachineGroupReference{MachineType: o.machineType, MachineGroup: o.machineGroup}
+	}
+	return labelSelector, ref, nil
+}
+
+func machineCommand(opts *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "machine",
+		Aliases: []string{"machines"},
+		Short:   "Manage machines",
+	}
+	cmd.AddCommand(
+		machineListCommand(opts),
+		machineScanCommand(opts),
+		machineInstallCommand(opts),
+		machinePackagesCommand(opts),
+		machineApproveCommand(opts),
+		machineRejectCommand(opts),
+		machineHistoryCommand(opts),
+	)
+	return cmd
+}
+
+func machineListCommand(opts *Options) *cobra.Command {
+	var (
+		labelSelector string
+		fieldSelector string
+		chunkSize     int64
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List machines",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			req := &machinev1alpha1.ListMachinesRequest{
+				Namespace:     opts.config.Namespace,
+				FieldSelector: fieldSelector,
+				PageSize:      chunkSize,
+			}
+			if labelSelector != "" {
+				if req.LabelSelector, err = metav1.ParseToLabelSelector(labelSelector); err != nil {
+					return fmt.Errorf("invalid label selector: %w", err)
+				}
+			}
+			result := &machinev1alpha1.ListMachinesResponse{}
+			for {
+				resp, err := client.ListMachines(cmd.Context(), connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				result.Machines = append(result.Machines, resp.Msg.Machines...)
+				if resp.Msg.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.Msg.NextPageToken
+			}
+			return p.print(result, machinesTable(result.Machines))
+		},
+	}
+	cmd.Flags().StringVarP(&labelSelector, "selector", "l", "", "label selector")
+	cmd.Flags().StringVar(&fieldSelector, "field-selector", "", "field selector")
+	cmd.Flags().Int64Var(&chunkSize, "chunk-size", 500,
+		"number of machines retrieved per request, 0 disables pagination")
+	return cmd
+}
+
+func machinesTable(machines []*machinev1alpha1.Machine) *table {
+	t := &table{header: []string{"NAME", "MACHINE TYPE", "LAST SCAN", "SCAN RESULT", "PACKAGES", "INSTALLED"}}
+	for _, machine := range machines {
+		var machineType string
+		if ref := machine.GetSpec().GetMachineTypeRef(); ref != nil {
+			machineType = ref.Name
+		}
+		t.append(
+			machine.GetObjectMeta().GetName(),
+			orNone(machineType),
+			timestamp(machine.GetStatus().GetLastScanTime()),
+			scanResult(machine.GetStatus().GetLastScanResult()),
+			packages(machine.GetSpec().GetPackages()),
+			packages(machine.GetStatus().GetInstalledPackages()),
+		)
+	}
+	return t
+}
+
+// scheduleFunc schedules a job either for the single machine, or for machines
+// matching selector, and returns the response with its tabular representation.
+type scheduleFunc func(
+	ctx context.Context,
+	client machinev1alpha1connect.MachineServiceClient,
+	namespace, name string,
+	labelSelector *metav1.LabelSelector,
+	ref *machinev1alpha1.MachineGroupReference,
+) (proto.Message, *table, error)
+
+func machineScheduleCommand(opts *Options, use, short string, schedule scheduleFunc) *cobra.Command {
+	var selector selectorOptions
+	cmd := &cobra.Command{
+		Use:   use + " [NAME]",
+		Short: short,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			var (
+				name          string
+				labelSelector *metav1.LabelSelector
+				ref           *machinev1alpha1.MachineGroupReference
+			)
+			if len(args) == 1 {
+				name = args[0]
+			} else if labelSelector, ref, err = selector.bulk(); err != nil {
+				return err
+			}
+			msg, t, err := schedule(cmd.Context(), client, opts.config.Namespace, name, labelSelector, ref)
+			if err != nil {
+				return err
+			}
+			return p.print(msg, t)
+		},
+	}
+	selector.addFlags(cmd.Flags())
+	return cmd
+}
+
+func machineScanCommand(opts *Options) *cobra.Command {
+	return machineScheduleCommand(opts, "scan",
+		"Schedule scan of installed firmware for the machine or machines matching selector",
+		func(
+			ctx context.Context,
+			client machinev1alpha1connect.MachineServiceClient,
+			namespace, name string,
+			labelSelector *metav1.LabelSelector,
+			ref *machinev1alpha1.MachineGroupReference,
+		) (proto.Message, *table, error) {
+			if name != "" {
+				resp, err := client.ScanMachine(ctx, connect.NewRequest(&machinev1alpha1.ScanMachineRequest{
+					Name:      name,
+					Namespace: namespace,
+				}))
+				if err != nil {
+					return nil, nil, err
+				}
+				return resp.Msg, resultTable(name, resp.Msg.Result, ""), nil
+			}
+			resp, err := client.ScanMachines(ctx, connect.NewRequest(&machinev1alpha1.ScanMachinesRequest{
+				Namespace:       namespace,
+				LabelSelector:   labelSelector,
+				MachineGroupRef: ref,
+			}))
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.Msg, resultsTable(resp.Msg.Results), nil
+		})
+}
+
+func machineInstallCommand(opts *Options) *cobra.Command {
+	return machineScheduleCommand(opts, "install",
+		"Schedule installation of firmware for the machine or machines matching selector",
+		func(
+			ctx context.Context,
+			client machinev1alpha1connect.MachineServiceClient,
+			namespace, name string,
+			labelSelector *metav1.LabelSelector,
+			ref *machinev1alpha1.MachineGroupReference,
+		) (proto.Message, *table, error) {
+			if name != "" {
+				resp, err := client.Install(ctx, connect.NewRequest(&machinev1alpha1.InstallRequest{
+					Name:      name,
+					Namespace: namespace,
+				}))
+				if err != nil {
+					return nil, nil, err
+				}
+				return resp.Msg, resultTable(name, resp.Msg.Result, ""), nil
+			}
+			resp, err := client.InstallMachines(ctx, connect.NewRequest(&machinev1alpha1.InstallMachinesRequest{
+				Namespace:       namespace,
+				LabelSelector:   labelSelector,
+				MachineGroupRef: ref,
+			}))
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.Msg, resultsTable(resp.Msg.Results), nil
+		})
+}
+
+// bulk returns selector of bulk request, which requires either label
+// selector or machine type to be set.
+func (o *selectorOptions) bulk() (*metav1.LabelSelector, *machinev1alpha1.MachineGroupReference, error) {
+	if !o.isSet() {
+		return nil, nil, errors.New("either machine name, or --selector, or --machine-type is required")
+	}
+	return o.selector()
+}
+
+func resultTable(name string, result commonv1alpha1.RequestResult, reason string) *table {
+	t := &table{header: []string{"NAME", "RESULT", "REASON"}}
+	t.append(name, requestResult(result), orNone(reason))
+	return t
+}
+
+func resultsTable(results []*machinev1alpha1.MachineRequestResult) *table {
+	t := &table{header: []string{"NAME", "RESULT", "REASON"}}
+	for _, result := range results {
+		t.append(result.Name, requestResult(result.Result), orNone(result.Reason))
+	}
+	return t
+}
+
+// parsePackages converts arguments of NAME=VERSION form to package versions.
+func parsePackages(args []string) ([]*commonv1alpha1.PackageVersion, error) {
+	result := make([]*commonv1alpha1.PackageVersion, 0, len(args))
+	for _, arg := range args {
+		name, version, ok := strings.Cut(arg, "=")
+		if !ok || name == "" || version == "" {
+			return nil, fmt.Errorf("invalid package %q, expected NAME=VERSION", arg)
+		}
+		result = append(result, &commonv1alpha1.PackageVersion{Name: name, Version: version})
+	}
+	return result, nil
+}
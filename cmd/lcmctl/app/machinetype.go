@@ -0,0 +1,348 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	machinetypev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machinetype/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func machineTypeCommand(opts *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "machinetype",
+		Aliases: []string{"machinetypes", "mt"},
+		Short:   "Manage machine types",
+	}
+	cmd.AddCommand(
+		machineTypeListCommand(opts),
+		machineTypeScanCommand(opts),
+		machineTypeGroupsCommand(opts),
+	)
+	return cmd
+}
+
+func machineTypeListCommand(opts *Options) *cobra.Command {
+	var (
+		labelSelector string
+		fieldSelector string
+		chunkSize     int64
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List machine types",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineTypeClient()
+			if err != nil {
+				return err
+			}
+			req := &machinetypev1alpha1.ListMachineTypesRequest{
+				Namespace:     opts.config.Namespace,
+				FieldSelector: fieldSelector,
+				PageSize:      chunkSize,
+			}
+			if labelSelector != "" {
+				if req.LabelSelector, err = metav1.ParseToLabelSelector(labelSelector); err != nil {
+					return fmt.Errorf("invalid label selector: %w", err)
+				}
+			}
+			result := &machinetypev1alpha1.ListMachineTypesResponse{}
+			for {
+				resp, err := client.ListMachineTypes(cmd.Context(), connect.NewRequest(req))
+				if err != nil {
+					return err
+				}
+				result.MachineTypes = append(result.MachineTypes, resp.Msg.MachineTypes...)
+				if resp.Msg.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.Msg.NextPageToken
+			}
+			return p.print(result, machineTypesTable(result.MachineTypes))
+		},
+	}
+	cmd.Flags().StringVarP(&labelSelector, "selector", "l", "", "label selector")
+	cmd.Flags().StringVar(&fieldSelector, "field-selector", "", "field selector")
+	cmd.Flags().Int64Var(&chunkSize, "chunk-size", 500,
+		"number of machine types retrieved per request, 0 disables pagination")
+	return cmd
+}
+
+func machineTypesTable(machineTypes []*machinetypev1alpha1.MachineType) *table {
+	t := &table{header: []string{"NAME", "MANUFACTURER", "TYPE", "GROUPS", "LAST SCAN", "SCAN RESULT"}}
+	for _, machineType := range machineTypes {
+		groups := make([]string, 0, len(machineType.GetSpec().GetMachineGroups()))
+		for _, group := range machineType.GetSpec().GetMachineGroups() {
+			groups = append(groups, group.Name)
+		}
+		t.append(
+			machineType.GetObjectMeta().GetName(),
+			orNone(machineType.GetSpec().GetManufacturer()),
+			orNone(machineType.GetSpec().GetType()),
+			orNone(strings.Join(groups, ",")),
+			timestamp(machineType.GetStatus().GetLastScanTime()),
+			scanResult(machineType.GetStatus().GetLastScanResult()),
+		)
+	}
+	return t
+}
+
+func machineTypeScanCommand(opts *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scan NAME",
+		Short: "Schedule scan of available firmware for the machine type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineTypeClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.Scan(cmd.Context(), connect.NewRequest(&machinetypev1alpha1.ScanRequest{
+				Name:      args[0],
+				Namespace: opts.config.Namespace,
+			}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, resultTable(args[0], resp.Msg.Result, ""))
+		},
+	}
+}
+
+func machineTypeGroupsCommand(opts *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "groups",
+		Aliases: []string{"group"},
+		Short:   "Manage machine groups of machine types",
+	}
+	cmd.AddCommand(
+		machineTypeGroupsAddCommand(opts),
+		machineTypeGroupsRemoveCommand(opts),
+	)
+	return cmd
+}
+
+func machineTypeGroupsAddCommand(opts *Options) *cobra.Command {
+	var (
+		resourceVersion string
+		flags           groupFlags
+	)
+	cmd := &cobra.Command{
+		Use:   "add MACHINETYPE GROUP",
+		Short: "Add machine group to the machine type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			group, err := flags.group(args[1], cmd.Flags())
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineTypeClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.AddMachineGroup(cmd.Context(),
+				connect.NewRequest(&machinetypev1alpha1.AddMachineGroupRequest{
+					Name:            args[0],
+					Namespace:       opts.config.Namespace,
+					MachineGroup:    group,
+					ResourceVersion: resourceVersion,
+				}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, resultTable(args[0], resp.Msg.Result, resp.Msg.Reason))
+		},
+	}
+	flags.addFlags(cmd.Flags())
+	cmd.Flags().StringVar(&resourceVersion, "resource-version", "",
+		"resource version the machine type is expected to have")
+	return cmd
+}
+
+// groupFlags defines the machine group added to the machine type.
+type groupFlags struct {
+	machineSelector string
+	packageArgs     []string
+	priority        int32
+	downgradePolicy string
+	windows         []string
+	approval        string
+	driftPolicy     string
+	rollout         rolloutFlags
+}
+
+func (f *groupFlags) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&f.machineSelector, "machine-selector", "", "label selector of machines in the group")
+	fs.StringSliceVar(&f.packageArgs, "package", nil, "desired package version in NAME=VERSION form")
+	fs.Int32Var(&f.priority, "priority", 0, "precedence of the group if machine matches several groups")
+	fs.StringVar(&f.downgradePolicy, "downgrade-policy", "",
+		"downgrade policy of the group: Deny, AllowWithAnnotation or Allow, inherited from machine type if empty")
+	fs.StringSliceVar(&f.windows, "maintenance-window", nil,
+		"name of maintenance window of the machine type applying to the group")
+	fs.StringVar(&f.approval, "approval", "",
+		"whether installation on machines of the group must be approved: None or Required, None if empty")
+	fs.StringVar(&f.driftPolicy, "drift-policy", "",
+		"how firmware drift of machines of the group is handled: Reconverge or Alert, Reconverge if empty")
+	f.rollout.addFlags(fs)
+}
+
+func (f *groupFlags) group(name string, fs *pflag.FlagSet) (*machinetypev1alpha1.MachineGroup, error) {
+	group := &machinetypev1alpha1.MachineGroup{
+		Name:               name,
+		Priority:           f.priority,
+		MaintenanceWindows: f.windows,
+	}
+	var err error
+	if group.MachineSelector, err = metav1.ParseToLabelSelector(f.machineSelector); err != nil {
+		return nil, fmt.Errorf("invalid machine selector: %w", err)
+	}
+	if group.Packages, err = parsePackages(f.packageArgs); err != nil {
+		return nil, err
+	}
+	if group.DowngradePolicy, err = parseDowngradePolicy(f.downgradePolicy); err != nil {
+		return nil, err
+	}
+	if group.Approval, err = parseApprovalMode(f.approval); err != nil {
+		return nil, err
+	}
+	if group.DriftPolicy, err = parseDriftPolicy(f.driftPolicy); err != nil {
+		return nil, err
+	}
+	if group.Rollout, err = f.rollout.strategy(fs); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// rolloutFlags defines rollout strategy of the machine group. Strategy is set
+// only if any of the flags is given.
+type rolloutFlags struct {
+	maxUnavailable       string
+	canaryBatchSize      int32
+	pauseBetweenBatches  time.Duration
+	maxFailurePercentage int32
+}
+
+var rolloutFlagNames = []string{
+	"max-unavailable", "canary-batch-size", "pause-between-batches", "max-failure-percentage",
+}
+
+func (f *rolloutFlags) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&f.maxUnavailable, "max-unavailable", "",
+		"rollout: number or percentage of machines installing packages at once, 1 if empty")
+	fs.Int32Var(&f.canaryBatchSize, "canary-batch-size", 0, "rollout: number of machines in the first batch")
+	fs.DurationVar(&f.pauseBetweenBatches, "pause-between-batches", 0, "rollout: pause between batches")
+	fs.Int32Var(&f.maxFailurePercentage, "max-failure-percentage", 0,
+		"rollout: percentage of failed machines halting the rollout")
+}
+
+func (f *rolloutFlags) strategy(fs *pflag.FlagSet) (*machinetypev1alpha1.RolloutStrategy, error) {
+	if !slices.ContainsFunc(rolloutFlagNames, fs.Changed) {
+		return nil, nil
+	}
+	if f.maxUnavailable != "" {
+		maxUnavailable := intstr.Parse(f.maxUnavailable)
+		if _, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, 100, true); err != nil {
+			return nil, fmt.Errorf("invalid max unavailable: %w", err)
+		}
+	}
+	return &machinetypev1alpha1.RolloutStrategy{
+		MaxUnavailable:       f.maxUnavailable,
+		CanaryBatchSize:      f.canaryBatchSize,
+		PauseBetweenBatches:  &metav1.Duration{Duration: f.pauseBetweenBatches},
+		MaxFailurePercentage: f.maxFailurePercentage,
+	}, nil
+}
+
+func machineTypeGroupsRemoveCommand(opts *Options) *cobra.Command {
+	var resourceVersion string
+	cmd := &cobra.Command{
+		Use:   "remove MACHINETYPE GROUP",
+		Short: "Remove machine group from the machine type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineTypeClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.RemoveMachineGroup(cmd.Context(),
+				connect.NewRequest(&machinetypev1alpha1.RemoveMachineGroupRequest{
+					Name:            args[0],
+					Namespace:       opts.config.Namespace,
+					GroupName:       args[1],
+					ResourceVersion: resourceVersion,
+				}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, resultTable(args[0], resp.Msg.Result, resp.Msg.Reason))
+		},
+	}
+	cmd.Flags().StringVar(&resourceVersion, "resource-version", "",
+		"resource version the machine type is expected to have")
+	return cmd
+}
+
+func parseDowngradePolicy(in string) (machinetypev1alpha1.DowngradePolicy, error) {
+	switch in {
+	case "":
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_UNSPECIFIED, nil
+	case "Deny":
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_DENY, nil
+	case "AllowWithAnnotation":
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_ALLOW_WITH_ANNOTATION, nil
+	case "Allow":
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_ALLOW, nil
+	}
+	return 0, fmt.Errorf("invalid downgrade policy %q, expected Deny, AllowWithAnnotation or Allow", in)
+}
+
+func parseApprovalMode(in string) (machinetypev1alpha1.ApprovalMode, error) {
+	switch in {
+	case "":
+		return machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_UNSPECIFIED, nil
+	case "None":
+		return machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_NONE, nil
+	case "Required":
+		return machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_REQUIRED, nil
+	}
+	return 0, fmt.Errorf("invalid approval mode %q, expected None or Required", in)
+}
+
+func parseDriftPolicy(in string) (machinetypev1alpha1.DriftPolicy, error) {
+	switch in {
+	case "":
+		return machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_UNSPECIFIED, nil
+	case "Reconverge":
+		return machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_RECONVERGE, nil
+	case "Alert":
+		return machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_ALERT, nil
+	}
+	return 0, fmt.Errorf("invalid drift policy %q, expected Reconverge or Alert", in)
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func machinePackagesCommand(opts *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packages",
+		Aliases: []string{"package", "pkg"},
+		Short:   "Manage desired firmware packages of machines",
+	}
+	cmd.AddCommand(
+		machinePackagesAddCommand(opts),
+		machinePackagesSetCommand(opts),
+		machinePackagesRemoveCommand(opts),
+	)
+	return cmd
+}
+
+func machinePackagesAddCommand(opts *Options) *cobra.Command {
+	var resourceVersion string
+	cmd := &cobra.Command{
+		Use:   "add MACHINE NAME=VERSION",
+		Short: "Add desired package version to the machine",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			pkgs, err := parsePackages(args[1:])
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.AddPackageVersion(cmd.Context(),
+				connect.NewRequest(&machinev1alpha1.AddPackageVersionRequest{
+					Name:            args[0],
+					Namespace:       opts.config.Namespace,
+					Package:         pkgs[0],
+					ResourceVersion: resourceVersion,
+				}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, resultTable(args[0], resp.Msg.Result, resp.Msg.Reason))
+		},
+	}
+	cmd.Flags().StringVar(&resourceVersion, "resource-version", "",
+		"resource version the machine is expected to have")
+	return cmd
+}
+
+func machinePackagesSetCommand(opts *Options) *cobra.Command {
+	var (
+		resourceVersion string
+		dryRun          bool
+		selector        selectorOptions
+	)
+	cmd := &cobra.Command{
+		Use:   "set [MACHINE] NAME=VERSION...",
+		Short: "Set desired package versions of the machine or machines matching selector",
+		Long: "Set desired package version of the machine. When --selector or --machine-type is passed, " +
+			"versions of all given packages are set for every matching machine, --dry-run prints the " +
+			"changes without applying them.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if selector.isSet() {
+				return setPackageVersions(cmd, opts, &selector, args, dryRun)
+			}
+			if len(args) != 2 {
+				return errors.New("exactly one machine and package are required without selector")
+			}
+			if dryRun {
+				return errors.New("--dry-run is supported only with selector")
+			}
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			pkgs, err := parsePackages(args[1:])
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.SetPackageVersion(cmd.Context(),
+				connect.NewRequest(&machinev1alpha1.SetPackageVersionRequest{
+					Name:            args[0],
+					Namespace:       opts.config.Namespace,
+					Package:         pkgs[0],
+					ResourceVersion: resourceVersion,
+				}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, resultTable(args[0], resp.Msg.Result, resp.Msg.Reason))
+		},
+	}
+	cmd.Flags().StringVar(&resourceVersion, "resource-version", "",
+		"resource version the machine is expected to have")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print changes without applying them")
+	selector.addFlags(cmd.Flags())
+	return cmd
+}
+
+func setPackageVersions(
+	cmd *cobra.Command,
+	opts *Options,
+	selector *selectorOptions,
+	args []string,
+	dryRun bool,
+) error {
+	p, err := opts.printer()
+	if err != nil {
+		return err
+	}
+	pkgs, err := parsePackages(args)
+	if err != nil {
+		return err
+	}
+	labelSelector, ref, err := selector.selector()
+	if err != nil {
+		return err
+	}
+	client, err := opts.machineClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.SetPackageVersions(cmd.Context(),
+		connect.NewRequest(&machinev1alpha1.SetPackageVersionsRequest{
+			Namespace:       opts.config.Namespace,
+			LabelSelector:   labelSelector,
+			MachineGroupRef: ref,
+			Packages:        pkgs,
+			DryRun:          dryRun,
+		}))
+	if err != nil {
+		return err
+	}
+	t := &table{header: []string{"NAME", "RESULT", "BEFORE", "AFTER", "REASON"}}
+	for _, diff := range resp.Msg.Results {
+		t.append(diff.Name, requestResult(diff.Result),
+			packages(diff.Before), packages(diff.After), orNone(diff.Reason))
+	}
+	return p.print(resp.Msg, t)
+}
+
+func machinePackagesRemoveCommand(opts *Options) *cobra.Command {
+	var resourceVersion string
+	cmd := &cobra.Command{
+		Use:   "remove MACHINE NAME",
+		Short: "Remove desired package version from the machine",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.RemovePackageVersion(cmd.Context(),
+				connect.NewRequest(&machinev1alpha1.RemovePackageVersionRequest{
+					Name:            args[0],
+					Namespace:       opts.config.Namespace,
+					PackageName:     args[1],
+					ResourceVersion: resourceVersion,
+				}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, resultTable(args[0], resp.Msg.Result, resp.Msg.Reason))
+		},
+	}
+	cmd.Flags().StringVar(&resourceVersion, "resource-version", "",
+		"resource version the machine is expected to have")
+	return cmd
+}
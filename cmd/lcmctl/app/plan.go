@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"connectrpc.com/connect"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func planCommand(opts *Options) *cobra.Command {
+	var (
+		selector    selectorOptions
+		pendingOnly bool
+	)
+	cmd := &cobra.Command{
+		Use:   "plan [MACHINE]",
+		Short: "Show firmware packages which would be installed on the machine or machines matching selector",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			req := &machinev1alpha1.GetInstallPlanRequest{Namespace: opts.config.Namespace}
+			if len(args) == 1 {
+				req.Name = args[0]
+			} else if req.LabelSelector, req.MachineGroupRef, err = selector.bulk(); err != nil {
+				return err
+			}
+			client, err := opts.machineClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetInstallPlan(cmd.Context(), connect.NewRequest(req))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, planTable(resp.Msg.Plans, pendingOnly))
+		},
+	}
+	selector.addFlags(cmd.Flags())
+	cmd.Flags().BoolVar(&pendingOnly, "pending", false, "show only packages pending installation")
+	return cmd
+}
+
+func planTable(plans []*machinev1alpha1.InstallPlan, pendingOnly bool) *table {
+	t := &table{header: []string{"MACHINE", "PACKAGE", "DESIRED", "SOURCE", "INSTALLED", "PENDING"}}
+	for _, plan := range plans {
+		for _, pkg := range plan.Packages {
+			if pendingOnly && !pkg.Pending {
+				continue
+			}
+			source := "machine"
+			if pkg.Source == machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE_GROUP {
+				source = "group/" + pkg.MachineGroup
+			}
+			pending := "no"
+			switch {
+			case pkg.Blocked:
+				pending = "no (downgrade blocked)"
+			case pkg.Downgrade:
+				pending = "yes (downgrade)"
+			case pkg.Pending:
+				pending = "yes"
+			}
+			desired := pkg.Version
+			if pkg.Constraint != "" {
+				desired += " (" + pkg.Constraint + ")"
+			}
+			t.append(plan.Name, pkg.Name, desired, source, orNone(pkg.InstalledVersion), pending)
+		}
+		// reason explains why the plan or some of its packages could not be
+		// computed
+		if plan.Reason != "" {
+			t.append(plan.Name, none, none, none, none, plan.Reason)
+		}
+	}
+	return t
+}
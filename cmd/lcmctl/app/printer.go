@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	tableFormat = "table"
+	jsonFormat  = "json"
+	yamlFormat  = "yaml"
+
+	none = "<none>"
+)
+
+// table is the tabular representation of the response.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) append(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+type printer struct {
+	format string
+	out    io.Writer
+}
+
+func newPrinter(format string, out io.Writer) (*printer, error) {
+	switch format {
+	case tableFormat, jsonFormat, yamlFormat:
+		return &printer{format: format, out: out}, nil
+	default:
+		return nil, fmt.Errorf("unsupported output format %q", format)
+	}
+}
+
+// print writes the response either as JSON or YAML document, or as a table.
+func (p *printer) print(msg proto.Message, t *table) error {
+	switch p.format {
+	case jsonFormat, yamlFormat:
+		data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if p.format == yamlFormat {
+			if data, err = yaml.JSONToYAML(data); err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintln(p.out, strings.TrimSpace(string(data)))
+		return err
+	default:
+		w := tabwriter.NewWriter(p.out, 0, 8, 3, ' ', 0)
+		_, _ = fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+}
+
+func requestResult(result commonv1alpha1.RequestResult) string {
+	return strings.TrimPrefix(result.String(), "REQUEST_RESULT_")
+}
+
+func scanResult(result commonv1alpha1.ScanResult) string {
+	return strings.TrimPrefix(result.String(), "SCAN_RESULT_")
+}
+
+func timestamp(ts *metav1.Timestamp) string {
+	if ts == nil || ts.Seconds <= 0 {
+		return none
+	}
+	return time.Unix(ts.Seconds, int64(ts.Nanos)).UTC().Format(time.RFC3339)
+}
+
+func packages(src []*commonv1alpha1.PackageVersion) string {
+	if len(src) == 0 {
+		return none
+	}
+	items := make([]string, 0, len(src))
+	for _, pkg := range src {
+		items = append(items, pkg.Name+"="+pkg.Version)
+	}
+	return strings.Join(items, ",")
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return none
+	}
+	return s
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const progressBarWidth = 40
+
+// progressBar renders transfer progress in a single line of the terminal.
+type progressBar struct {
+	out     io.Writer
+	total   int64
+	current int64
+}
+
+func newProgressBar(out io.Writer, total int64) *progressBar {
+	return &progressBar{out: out, total: total}
+}
+
+func (b *progressBar) set(current int64) {
+	b.current = min(current, b.total)
+	b.render()
+}
+
+func (b *progressBar) add(n int64) {
+	b.set(b.current + n)
+}
+
+func (b *progressBar) finish() {
+	_, _ = fmt.Fprintln(b.out)
+}
+
+func (b *progressBar) render() {
+	ratio := 1.0
+	if b.total > 0 {
+		ratio = float64(b.current) / float64(b.total)
+	}
+	filled := int(ratio * progressBarWidth)
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled)
+	_, _ = fmt.Fprintf(b.out, "\r[%s] %3d%% %s / %s", bar, int(ratio*100), byteSize(b.current), byteSize(b.total))
+}
+
+// byteSize formats the number of bytes with binary prefix.
+func byteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"strconv"
+
+	"connectrpc.com/connect"
+	adminv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/admin/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func schedulingCommand(opts *Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduling",
+		Short: "Pause and resume scheduling of jobs by lifecycle-service",
+	}
+	cmd.AddCommand(
+		schedulingPauseCommand(opts),
+		schedulingResumeCommand(opts),
+		schedulingStatusCommand(opts),
+	)
+	return cmd
+}
+
+func schedulingPauseCommand(opts *Options) *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Stop starting new jobs, requests are still accepted and queued",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.adminClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.PauseScheduling(cmd.Context(), connect.NewRequest(&adminv1alpha1.PauseSchedulingRequest{
+				Reason: reason,
+			}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, schedulingTable(resp.Msg.GetStatus()))
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "reason of the pause")
+	return cmd
+}
+
+func schedulingResumeCommand(opts *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume",
+		Short: "Start jobs for queued requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.adminClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.ResumeScheduling(cmd.Context(),
+				connect.NewRequest(&adminv1alpha1.ResumeSchedulingRequest{}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, schedulingTable(resp.Msg.GetStatus()))
+		},
+	}
+}
+
+func schedulingStatusCommand(opts *Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show whether scheduling is paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := opts.printer()
+			if err != nil {
+				return err
+			}
+			client, err := opts.adminClient()
+			if err != nil {
+				return err
+			}
+			resp, err := client.GetSchedulingStatus(cmd.Context(),
+				connect.NewRequest(&adminv1alpha1.GetSchedulingStatusRequest{}))
+			if err != nil {
+				return err
+			}
+			return p.print(resp.Msg, schedulingTable(resp.Msg.GetStatus()))
+		},
+	}
+}
+
+func schedulingTable(status *adminv1alpha1.SchedulingStatus) *table {
+	t := &table{header: []string{"PAUSED", "USER", "REASON", "SINCE"}}
+	t.append(strconv.FormatBool(status.GetPaused()), orNone(status.GetUser()), orNone(status.GetReason()),
+		timestamp(status.GetPauseTime()))
+	return t
+}
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ironcore-dev/lifecycle-manager/cmd/lcmctl/app"
+)
+
+func main() {
+	ctx := app.SetupSignalHandler()
+
+	if err := app.Command().ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
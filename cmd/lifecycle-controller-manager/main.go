@@ -27,12 +27,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	"github.com/ironcore-dev/lifecycle-manager/internal/controllers"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -107,7 +109,8 @@ func setupControllers(mgr ctrl.Manager, endpoint string, horizon time.Duration)
 		Client:               mgr.GetClient(),
 		Scheme:               mgr.GetScheme(),
 		Log:                  mgr.GetLogger().WithName("lifecycle-machine-controller"),
-		MachineServiceClient: setupMachineClient(endpoint, httpClient),
+		Recorder:             mgr.GetEventRecorderFor("lifecycle-machine-controller"),
+		MachineServiceClient: setupMachineClient(endpoint, httpClient, mgr.GetConfig()),
 		Horizon:              horizon,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Machine")
@@ -117,7 +120,8 @@ func setupControllers(mgr ctrl.Manager, endpoint string, horizon time.Duration)
 		Client:                   mgr.GetClient(),
 		Scheme:                   mgr.GetScheme(),
 		Log:                      mgr.GetLogger().WithName("lifecycle-machinetype-controller"),
-		MachineTypeServiceClient: setupMachineTypeClient(endpoint, httpClient),
+		Recorder:                 mgr.GetEventRecorderFor("lifecycle-machinetype-controller"),
+		MachineTypeServiceClient: setupMachineTypeClient(endpoint, httpClient, mgr.GetConfig()),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MachineType")
 		return err
@@ -126,6 +130,7 @@ func setupControllers(mgr ctrl.Manager, endpoint string, horizon time.Duration)
 		Client:        mgr.GetClient(),
 		Log:           mgr.GetLogger().WithName("lifecycle-onboarding-controller"),
 		Scheme:        mgr.GetScheme(),
+		Recorder:      mgr.GetEventRecorderFor("lifecycle-onboarding-controller"),
 		RequeuePeriod: time.Minute,
 		ScanPeriod:    v1.Duration{Duration: time.Hour * 24},
 	}).SetupWithManager(mgr); err != nil {
@@ -159,10 +164,20 @@ func setupHTTPClient() *http.Client {
 	}
 }
 
-func setupMachineClient(endpoint string, cl *http.Client) machinev1alpha1connect.MachineServiceClient {
-	return machinev1alpha1connect.NewMachineServiceClient(cl, endpoint, connect.WithGRPC())
+func setupMachineClient(
+	endpoint string,
+	cl *http.Client,
+	cfg *rest.Config,
+) machinev1alpha1connect.MachineServiceClient {
+	return machinev1alpha1connect.NewMachineServiceClient(cl, endpoint, connect.WithGRPC(),
+		connect.WithInterceptors(interceptor.NewTokenInterceptor(cfg)))
 }
 
-func setupMachineTypeClient(endpoint string, cl *http.Client) machinetypev1alpha1connect.MachineTypeServiceClient {
-	return machinetypev1alpha1connect.NewMachineTypeServiceClient(cl, endpoint, connect.WithGRPC())
+func setupMachineTypeClient(
+	endpoint string,
+	cl *http.Client,
+	cfg *rest.Config,
+) machinetypev1alpha1connect.MachineTypeServiceClient {
+	return machinetypev1alpha1connect.NewMachineTypeServiceClient(cl, endpoint, connect.WithGRPC(),
+		connect.WithInterceptors(interceptor.NewTokenInterceptor(cfg)))
 }
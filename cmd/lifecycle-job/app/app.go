@@ -17,13 +17,19 @@ import (
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machinetype/v1alpha1/machinetypev1alpha1connect"
 	"github.com/ironcore-dev/lifecycle-manager/internal/job"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
 	oobv1alpha1 "github.com/ironcore-dev/oob/api/v1alpha1"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/net/http2"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
@@ -102,18 +108,22 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 
+	broadcaster := setupEventBroadcaster(cfg)
+	defer broadcaster.Shutdown()
+
 	workerOpts := job.Options{
 		KubeClient: cl,
+		Recorder:   broadcaster.NewRecorder(scheme, corev1.EventSource{Component: "lifecycle-job"}),
 		Log:        setupLogger(LogFormat(opts.logFormat), logLevelMapping[opts.logLevel], opts.dev),
 		JobID:      opts.jobID,
 	}
 	switch opts.targetType {
 	case "machine":
 		w = job.NewMachineLifecycleWorker(workerOpts).
-			WithClient(setupMachineClient(opts.lcmEndpoint, setupHTTPClient()))
+			WithClient(setupMachineClient(opts.lcmEndpoint, setupHTTPClient(), cfg))
 	case "machinetype":
 		w = job.NewMachineTypeLifecycleWorker(workerOpts).
-			WithClient(setupMachineTypeClient(opts.lcmEndpoint, setupHTTPClient()))
+			WithClient(setupMachineTypeClient(opts.lcmEndpoint, setupHTTPClient(), cfg))
 	}
 	if w == nil {
 		return fmt.Errorf("no worker implementation")
@@ -137,6 +147,13 @@ func setupLogger(format LogFormat, level slog.Leveler, dev bool) *slog.Logger {
 	return nil
 }
 
+func setupEventBroadcaster(cfg *rest.Config) record.EventBroadcaster {
+	clientset := kubernetes.NewForConfigOrDie(cfg)
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	return broadcaster
+}
+
 func setupHTTPClient() *http.Client {
 	return &http.Client{
 		Transport: &http2.Transport{
@@ -148,10 +165,20 @@ func setupHTTPClient() *http.Client {
 	}
 }
 
-func setupMachineClient(endpoint string, cl *http.Client) machinev1alpha1connect.MachineServiceClient {
-	return machinev1alpha1connect.NewMachineServiceClient(cl, endpoint, connect.WithGRPC())
+func setupMachineClient(
+	endpoint string,
+	cl *http.Client,
+	cfg *rest.Config,
+) machinev1alpha1connect.MachineServiceClient {
+	return machinev1alpha1connect.NewMachineServiceClient(cl, endpoint, connect.WithGRPC(),
+		connect.WithInterceptors(interceptor.NewTokenInterceptor(cfg)))
 }
 
-func setupMachineTypeClient(endpoint string, cl *http.Client) machinetypev1alpha1connect.MachineTypeServiceClient {
-	return machinetypev1alpha1connect.NewMachineTypeServiceClient(cl, endpoint, connect.WithGRPC())
+func setupMachineTypeClient(
+	endpoint string,
+	cl *http.Client,
+	cfg *rest.Config,
+) machinetypev1alpha1connect.MachineTypeServiceClient {
+	return machinetypev1alpha1connect.NewMachineTypeServiceClient(cl, endpoint, connect.WithGRPC(),
+		connect.WithInterceptors(interceptor.NewTokenInterceptor(cfg)))
 }
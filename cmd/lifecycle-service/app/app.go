@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ironcore-dev/lifecycle-manager/internal/service"
+	adminsvcv1alpha1 "github.com/ironcore-dev/lifecycle-manager/internal/service/admin/v1alpha1"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -36,11 +37,18 @@ type Options struct {
 	host       string
 	port       int
 	namespace  string
+	watchNs    []string
 	jobsConfig string
 	horizon    time.Duration
 	workers    uint64
 	queue      uint64
+	history    int
 	dev        bool
+
+	schedulingState string
+
+	authorization    bool
+	authorizationTTL time.Duration
 }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
@@ -50,11 +58,20 @@ func (o *Options) addFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.host, "host", "", "bind host")
 	fs.IntVar(&o.port, "port", 8080, "bind port")
 	fs.StringVar(&o.namespace, "namespace", "default", "default namespace name")
+	fs.StringSliceVar(&o.watchNs, "watch-namespaces", nil,
+		"namespaces to cache machines and machine types from, '*' stands for all namespaces (default [--namespace])")
 	fs.StringVar(&o.jobsConfig, "jobs-config", "", "name of the config map containing jobs parameters")
 	fs.DurationVar(&o.horizon, "horizon", time.Minute*30, "allowed lag for scan period check")
 	fs.Uint64Var(&o.workers, "workers", 5, "number of workers to process tasks")
 	fs.Uint64Var(&o.queue, "queue-capacity", 1024, "size of the scheduler's queue")
+	fs.IntVar(&o.history, "history-limit", 100, "number of firmware changes kept in machine's history")
 	fs.BoolVar(&o.dev, "dev", false, "development mode flag")
+	fs.StringVar(&o.schedulingState, "scheduling-state-config-map", adminsvcv1alpha1.DefaultStateConfigMap,
+		"name of the config map persisting paused scheduling, empty keeps it in memory only")
+	fs.BoolVar(&o.authorization, "authorization", false,
+		"authorize requests with kubernetes TokenReview and SubjectAccessReview")
+	fs.DurationVar(&o.authorizationTTL, "authorization-cache-ttl", time.Minute,
+		"how long authorization decisions are cached")
 }
 
 func Command() *cobra.Command {
@@ -82,15 +99,22 @@ func Run(ctx context.Context, opts Options) error {
 	}
 
 	srvOpts := service.Options{
-		Cfg:           cfg,
-		Log:           setupLogger(LogFormat(opts.logFormat), logLevelMapping[opts.logLevel], opts.dev),
-		Host:          opts.host,
-		Port:          opts.port,
-		Namespace:     opts.namespace,
-		Horizon:       opts.horizon,
-		Workers:       opts.workers,
-		QueueCapacity: opts.queue,
-		JobsConfig:    opts.jobsConfig,
+		Cfg:             cfg,
+		Log:             setupLogger(LogFormat(opts.logFormat), logLevelMapping[opts.logLevel], opts.dev),
+		Host:            opts.host,
+		Port:            opts.port,
+		Namespace:       opts.namespace,
+		WatchNamespaces: opts.watchNs,
+		Horizon:         opts.horizon,
+		Workers:         opts.workers,
+		QueueCapacity:   opts.queue,
+		JobsConfig:      opts.jobsConfig,
+		HistoryLimit:    opts.history,
+
+		SchedulingStateConfigMap: opts.schedulingState,
+
+		Authorization:    opts.authorization,
+		AuthorizationTTL: opts.authorizationTTL,
 	}
 	srv := service.NewGrpcServer(srvOpts)
 	return srv.Start(ctx)
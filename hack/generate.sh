@@ -35,6 +35,8 @@ DEEPCOPY_GEN="$DEEPCOPY_GEN"
 OPENAPI_GEN="$OPENAPI_GEN"
 APPLYCONFIGURATION_GEN="$APPLYCONFIGURATION_GEN"
 CLIENT_GEN="$CLIENT_GEN"
+LISTER_GEN="$LISTER_GEN"
+INFORMER_GEN="$INFORMER_GEN"
 
 VIRTUAL_GOPATH="$(mktemp -d)"
 trap 'rm -rf "$VIRTUAL_GOPATH"' EXIT
@@ -89,3 +91,19 @@ echo "Generating ${blue}client${normal}"
   --apply-configuration-package "github.com/ironcore-dev/lifecycle-manager/clientgo/applyconfiguration" \
   --clientset-name "lifecycle" \
   --input-base ""
+
+echo "Generating ${blue}lister${normal}"
+"$LISTER_GEN" \
+  --output-base "$GOPATH/src" \
+  --go-header-file "$SCRIPT_DIR/boilerplate.go.txt" \
+  --input-dirs "$(qualify-gvs "github.com/ironcore-dev/lifecycle-manager/api" "$CLIENT_VERSION_GROUPS")" \
+  --output-package "github.com/ironcore-dev/lifecycle-manager/clientgo/listers"
+
+echo "Generating ${blue}informer${normal}"
+"$INFORMER_GEN" \
+  --output-base "$GOPATH/src" \
+  --go-header-file "$SCRIPT_DIR/boilerplate.go.txt" \
+  --input-dirs "$(qualify-gvs "github.com/ironcore-dev/lifecycle-manager/api" "$CLIENT_VERSION_GROUPS")" \
+  --versioned-clientset-package "github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle" \
+  --listers-package "github.com/ironcore-dev/lifecycle-manager/clientgo/listers" \
+  --output-package "github.com/ironcore-dev/lifecycle-manager/clientgo/informers"
@@ -96,17 +96,20 @@ var _ = BeforeSuite(func() {
 	Expect((&controllers.OnboardingReconciler{
 		Client:        k8sClient,
 		Scheme:        scheme,
+		Recorder:      k8sManager.GetEventRecorderFor("lifecycle-onboarding-controller"),
 		RequeuePeriod: requeuePeriod,
 		ScanPeriod:    scanPeriod,
 	}).SetupWithManager(k8sManager)).To(Succeed())
 	Expect((&controllers.MachineTypeReconciler{
 		Client:                   k8sClient,
 		Scheme:                   scheme,
+		Recorder:                 k8sManager.GetEventRecorderFor("lifecycle-machinetype-controller"),
 		MachineTypeServiceClient: nil, // todo: setup broker client
 	}).SetupWithManager(k8sManager)).To(Succeed())
 	Expect((&controllers.MachineReconciler{
 		Client:               k8sClient,
 		Scheme:               scheme,
+		Recorder:             k8sManager.GetEventRecorderFor("lifecycle-machine-controller"),
 		MachineServiceClient: nil, // todo: setup broker client
 		Namespace:            namespace,
 	}).SetupWithManager(k8sManager)).To(Succeed())
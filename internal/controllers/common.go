@@ -30,6 +30,37 @@ const (
 	StatusMessageInstallRequestFailed     = "install request failed"
 	StatusMessageInstallRequestProcessing = "installation is in progress"
 	StatusMessageInstallRequestSuccessful = "install request submitted"
+	StatusMessageGroupConflict            = "machine groups conflict"
+	StatusMessageRolloutWaiting           = "waiting for machine group rollout"
+	StatusMessageMaintenanceWindowWaiting = "waiting for maintenance window"
+	StatusMessageApprovalWaiting          = "waiting for install approval"
+	StatusMessagePaused                   = "lifecycle operations are paused"
+	StatusMessageDriftDetected            = "firmware drift detected"
+)
+
+const (
+	ReasonMultipleGroupsMatched  = "MultipleGroupsMatched"
+	ReasonNoMatchingVersion      = "NoMatchingVersion"
+	ReasonDowngradeDenied        = "DowngradeDenied"
+	ReasonOutsideWindow          = "OutsideMaintenanceWindow"
+	ReasonInvalidWindow          = "InvalidMaintenanceWindow"
+	ReasonAwaitingApproval       = "AwaitingApproval"
+	ReasonInstallRejected        = "InstallRejected"
+	ReasonMachinePaused          = "MachinePaused"
+	ReasonMachineTypePaused      = "MachineTypePaused"
+	ReasonNotScanned             = "NotScanned"
+	ReasonScanSucceeded          = "ScanSucceeded"
+	ReasonScanFailed             = "ScanFailed"
+	ReasonRequestSucceeded       = "RequestSucceeded"
+	ReasonRequestFailed          = "RequestFailed"
+	ReasonPackagesInstalled      = "PackagesInstalled"
+	ReasonPackagesPending        = "PackagesPending"
+	ReasonPackagesNotInstallable = "PackagesNotInstallable"
+	ReasonNoPendingPackages      = "NoPendingPackages"
+	ReasonInstallBlocked         = "InstallBlocked"
+	ReasonInstallScheduled       = "InstallScheduled"
+	ReasonInstallInProgress      = "InstallInProgress"
+	ReasonInstallRequestFailed   = "InstallRequestFailed"
 )
 
 func (r RequestResult) IsScheduled() bool {
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
+)
+
+// setScanConditions reflects the result of the last scan reported by the job
+// in Scanned and ScanFailed conditions.
+func setScanConditions(
+	conditions *[]metav1.Condition,
+	generation int64,
+	result lifecyclev1alpha1.ScanResult,
+	lastScanTime metav1.Time,
+) {
+	scanned := metav1.Condition{
+		Type:               lifecyclev1alpha1.ConditionScanned,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             ReasonNotScanned,
+		Message:            "no scan reported yet",
+	}
+	failed := metav1.Condition{
+		Type:               lifecyclev1alpha1.ConditionScanFailed,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             ReasonNotScanned,
+		Message:            scanned.Message,
+	}
+	switch {
+	case result.IsSuccess():
+		scanned.Status = metav1.ConditionTrue
+		scanned.Reason = ReasonScanSucceeded
+		scanned.Message = fmt.Sprintf("last scan succeeded at %s", lastScanTime.Format(time.RFC3339))
+		failed.Reason, failed.Message = scanned.Reason, scanned.Message
+	case result.IsFailure():
+		failed.Status = metav1.ConditionTrue
+		failed.Reason = ReasonScanFailed
+		failed.Message = fmt.Sprintf("last scan failed at %s", lastScanTime.Format(time.RFC3339))
+		scanned.Reason, scanned.Message = failed.Reason, failed.Message
+	}
+	meta.SetStatusCondition(conditions, scanned)
+	meta.SetStatusCondition(conditions, failed)
+}
+
+// setServiceReachableCondition reflects the outcome of the last request to
+// lifecycle-service.
+func setServiceReachableCondition(conditions *[]metav1.Condition, generation int64, err error) {
+	condition := metav1.Condition{
+		Type:               lifecyclev1alpha1.ConditionServiceReachable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             ReasonRequestSucceeded,
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonRequestFailed
+		condition.Message = err.Error()
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
+
+// reportRequestFailure records the failed request to lifecycle-service in
+// object's status and events. Status is patched right away, since
+// reconciliation interrupted by an error does not patch it.
+func reportRequestFailure(
+	ctx context.Context,
+	c client.Client,
+	recorder record.EventRecorder,
+	obj client.Object,
+	conditions *[]metav1.Condition,
+	request string,
+	err error,
+) {
+	log := logr.FromContextOrDiscard(ctx)
+	recorder.Eventf(obj, corev1.EventTypeWarning, lifecyclev1alpha1.EventReasonRequestFailed,
+		"%s request failed: %s", request, err)
+	setServiceReachableCondition(conditions, obj.GetGeneration(), err)
+	if patchErr := c.Status().Patch(ctx, obj, client.Merge); patchErr != nil {
+		log.Error(patchErr, "failed to update object status")
+	}
+}
+
+// setUpToDateCondition reflects whether desired packages of the machine are
+// installed according to the install plan.
+func setUpToDateCondition(obj *lifecyclev1alpha1.Machine, plan planutil.Plan) {
+	condition := metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionUpToDate,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+		Reason:             ReasonPackagesInstalled,
+		Message:            "desired packages are installed",
+	}
+	switch {
+	case len(plan.Pending) > 0:
+		items := make([]string, len(plan.Pending))
+		for i, pv := range plan.Pending {
+			items[i] = fmt.Sprintf("%s %s", pv.Name, pv.Version)
+		}
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonPackagesPending
+		condition.Message = "pending packages: " + strings.Join(items, ", ")
+	case len(plan.Unresolved) > 0 || len(plan.Blocked) > 0:
+		// such packages are reported by their own conditions
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonPackagesNotInstallable
+		condition.Message = "some desired packages can not be installed"
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, condition)
+}
+
+// setUpToDateUnknownCondition reports that it is unknown whether desired
+// packages of the machine are installed.
+func setUpToDateUnknownCondition(obj *lifecyclev1alpha1.Machine, reason, message string) {
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionUpToDate,
+		Status:             metav1.ConditionUnknown,
+		ObservedGeneration: obj.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setInstallingCondition reflects whether installation of pending packages is
+// requested or in progress. Installation reported in progress by the job
+// takes precedence, since installation gates might close while the job runs.
+func setInstallingCondition(
+	obj *lifecyclev1alpha1.Machine,
+	status metav1.ConditionStatus,
+	reason, message string,
+) {
+	if idx := slices.IndexFunc(obj.Status.PackageStatuses, func(item lifecyclev1alpha1.PackageInstallStatus) bool {
+		return item.State.IsActive()
+	}); idx >= 0 {
+		item := obj.Status.PackageStatuses[idx]
+		status, reason = metav1.ConditionTrue, ReasonInstallInProgress
+		message = fmt.Sprintf("package %s %s: %s", item.Name, item.Version, item.State)
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionInstalling,
+		Status:             status,
+		ObservedGeneration: obj.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// blockInstall reports that installation of pending packages waits for the
+// gate described by the status message.
+func blockInstall(obj *lifecyclev1alpha1.Machine) {
+	setInstallingCondition(obj, metav1.ConditionFalse, ReasonInstallBlocked, obj.Status.Message)
+}
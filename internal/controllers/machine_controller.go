@@ -5,17 +5,22 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -28,6 +33,11 @@ import (
 
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/approvalutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/driftutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/rolloututil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/windowutil"
 )
 
 // MachineReconciler reconciles a Machine object.
@@ -38,13 +48,16 @@ type MachineReconciler struct {
 	Namespace string
 	Horizon   time.Duration
 
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machines/finalizers,verbs=update
+// +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machines/scan;machines/install,verbs=create
 
 func (r *MachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (reconcile.Result, error) {
 	log := logr.FromContextOrDiscard(ctx)
@@ -52,6 +65,9 @@ func (r *MachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 
 	obj := &lifecyclev1alpha1.Machine{}
 	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			setPausedMetric(kindMachine, req.Namespace, req.Name, false)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
@@ -84,27 +100,139 @@ func (r *MachineReconciler) reconcileRequired(
 }
 
 func (r *MachineReconciler) reconcile(ctx context.Context, obj *lifecyclev1alpha1.Machine) (reconcile.Result, error) {
+	status := &obj.Status
+	setScanConditions(&status.Conditions, obj.Generation, status.LastScanResult, status.LastScanTime)
+	paused, err := r.paused(ctx, obj)
+	if err != nil || paused {
+		return reconcile.Result{}, err
+	}
 	if obj.Status.LastScanTime.IsZero() {
+		// installed packages are unknown until the first scan
+		setUpToDateUnknownCondition(obj, ReasonNotScanned, "installed packages are not scanned yet")
 		return r.scan(ctx, obj)
 	}
 	if time.Since(obj.Status.LastScanTime.Time) > r.Horizon {
 		return r.scan(ctx, obj)
 	}
-	diff, err := r.packagesToInstall(ctx, obj)
+	plan, machineType, err := r.installPlan(ctx, obj)
+	var conflict *planutil.GroupConflictError
+	if errors.As(err, &conflict) {
+		// conflict might be resolved only by changing machine type or
+		// machine labels, both trigger reconciliation
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               lifecyclev1alpha1.MachineConditionGroupConflict,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: obj.Generation,
+			Reason:             ReasonMultipleGroupsMatched,
+			Message:            conflict.Error(),
+		})
+		setUpToDateUnknownCondition(obj, ReasonMultipleGroupsMatched, "desired packages are unknown")
+		obj.Status.Message = StatusMessageGroupConflict
+		return reconcile.Result{}, nil
+	}
 	if err != nil {
 		return reconcile.Result{}, err
 	}
-	if len(diff) == 0 {
+	meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionGroupConflict)
+	setVersionUnresolvedCondition(obj, plan.Unresolved)
+	setDowngradeBlockedCondition(obj, plan.Blocked)
+	setUpToDateCondition(obj, plan)
+	// effective desired state is kept in status, so spec stays owned by
+	// the user and changes of machine group defaults apply to the machine
+	obj.Status.DesiredPackages = plan.DesiredPackageVersions()
+	if len(plan.Pending) == 0 {
+		// drift is resolved once installed versions match desired ones, either
+		// by installation or by change of desired versions, changes of packages
+		// which are not managed are reported until the next scan
+		if driftutil.Drifted(obj) {
+			meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionFirmwareDrift)
+		}
+		removeWaitingConditions(obj)
+		setInstallingCondition(obj, metav1.ConditionFalse, ReasonNoPendingPackages, "")
 		obj.Status.Message = ""
+		return reconcile.Result{}, r.clearApproval(ctx, obj)
+	}
+	return r.installPending(ctx, obj, machineType, plan)
+}
+
+// installPending requests installation of pending packages once the machine
+// is admitted to the rollout of its group, the installation is approved if
+// the group requires it and maintenance window is open. Drifted machines are
+// installed only if the drift policy of the group allows it.
+func (r *MachineReconciler) installPending(
+	ctx context.Context,
+	obj *lifecyclev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+	plan planutil.Plan,
+) (reconcile.Result, error) {
+	// drifted machine is only reported until the drift is resolved, if the
+	// group does not allow to re-converge it
+	if driftutil.Drifted(obj) && !driftutil.Reconverge(plan.Group) {
+		removeWaitingConditions(obj)
+		obj.Status.Message = StatusMessageDriftDetected
+		blockInstall(obj)
 		return reconcile.Result{}, nil
 	}
-	obj.Spec.Packages = mergePackageVersion(obj.Spec.Packages, diff)
-	if err = r.Patch(ctx, obj, client.Merge); err != nil {
+	// packages of the group are installed once the machine is admitted to
+	// the rollout by machine type controller
+	if plan.GroupPending() && !rolloututil.Admitted(obj, machineType, plan.Group) {
+		removeWaitingConditions(obj)
+		obj.Status.Message = StatusMessageRolloutWaiting
+		blockInstall(obj)
+		return reconcile.Result{}, nil
+	}
+	if wait, err := r.waitForApproval(ctx, obj, plan); err != nil || wait {
+		if wait {
+			blockInstall(obj)
+		}
+		return reconcile.Result{}, err
+	}
+	if result, wait := waitForMaintenanceWindow(obj, machineType, plan.Group); wait {
+		blockInstall(obj)
+		return result, nil
+	}
+	// install job reads desired packages reflected in status from API server
+	// once it starts, therefore status must be updated before install is
+	// requested
+	if err := r.Status().Patch(ctx, obj, client.Merge); err != nil {
 		return reconcile.Result{}, err
 	}
 	return r.install(ctx, obj)
 }
 
+// paused reports whether lifecycle operations of the machine are paused by
+// the machine itself or by its machine type and sets the condition
+// accordingly. Missing machine type does not pause the machine.
+func (r *MachineReconciler) paused(ctx context.Context, obj *lifecyclev1alpha1.Machine) (bool, error) {
+	condition := metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionPaused,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+	}
+	machineType := &lifecyclev1alpha1.MachineType{}
+	key := types.NamespacedName{Name: obj.Spec.MachineTypeRef.Name, Namespace: obj.Namespace}
+	err := r.Get(ctx, key, machineType)
+	switch {
+	case lifecyclev1alpha1.IsPaused(obj):
+		condition.Reason = ReasonMachinePaused
+		condition.Message = fmt.Sprintf("machine is annotated with %s", lifecyclev1alpha1.PausedAnnotation)
+	case client.IgnoreNotFound(err) != nil:
+		return false, err
+	case err == nil && lifecyclev1alpha1.IsPaused(machineType):
+		condition.Reason = ReasonMachineTypePaused
+		condition.Message = fmt.Sprintf("machine type %s is annotated with %s",
+			machineType.Name, lifecyclev1alpha1.PausedAnnotation)
+	default:
+		meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionPaused)
+		setPausedMetric(kindMachine, obj.Namespace, obj.Name, false)
+		return false, nil
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, condition)
+	obj.Status.Message = StatusMessagePaused
+	setPausedMetric(kindMachine, obj.Namespace, obj.Name, true)
+	return true, nil
+}
+
 func (r *MachineReconciler) scan(ctx context.Context, obj *lifecyclev1alpha1.Machine) (reconcile.Result, error) {
 	log := logr.FromContextOrDiscard(ctx)
 	resp, err := r.ScanMachine(ctx, connect.NewRequest(&machinev1alpha1.ScanMachineRequest{
@@ -113,8 +241,10 @@ func (r *MachineReconciler) scan(ctx context.Context, obj *lifecyclev1alpha1.Mac
 	}))
 	if err != nil {
 		log.Error(err, "failed to send scan request")
+		reportRequestFailure(ctx, r.Client, r.Recorder, obj, &obj.Status.Conditions, "scan", err)
 		return reconcile.Result{}, err
 	}
+	setServiceReachableCondition(&obj.Status.Conditions, obj.Generation, nil)
 	scanResponse := resp.Msg
 	result := reconcile.Result{}
 	switch {
@@ -137,18 +267,24 @@ func (r *MachineReconciler) install(ctx context.Context, obj *lifecyclev1alpha1.
 	}))
 	if err != nil {
 		log.Error(err, "failed to send install request")
+		setInstallingCondition(obj, metav1.ConditionFalse, ReasonInstallRequestFailed, err.Error())
+		reportRequestFailure(ctx, r.Client, r.Recorder, obj, &obj.Status.Conditions, "install", err)
 		return reconcile.Result{}, err
 	}
+	setServiceReachableCondition(&obj.Status.Conditions, obj.Generation, nil)
 	installResponse := resp.Msg
 	result := reconcile.Result{}
 	switch {
 	case LCIMRequestResultToString[installResponse.Result].IsFailure():
 		obj.Status.Message = StatusMessageInstallRequestFailed
+		setInstallingCondition(obj, metav1.ConditionFalse, ReasonInstallRequestFailed, obj.Status.Message)
 		result.RequeueAfter = requeuePeriod
 	case LCIMRequestResultToString[installResponse.Result].IsScheduled():
 		obj.Status.Message = StatusMessageInstallRequestProcessing
+		setInstallingCondition(obj, metav1.ConditionTrue, ReasonInstallScheduled, obj.Status.Message)
 	case LCIMRequestResultToString[installResponse.Result].IsSuccess():
 		obj.Status.Message = StatusMessageInstallRequestSuccessful
+		setInstallingCondition(obj, metav1.ConditionTrue, ReasonInstallScheduled, obj.Status.Message)
 	}
 	return result, nil
 }
@@ -193,11 +329,22 @@ func (r *MachineReconciler) enqueueOnMachineTypeUpdate(
 	if oldMachineType.Namespace != r.Namespace || newMachineType.Namespace != r.Namespace {
 		return
 	}
-	if reflect.DeepEqual(oldMachineType.Spec.MachineGroups, newMachineType.Spec.MachineGroups) {
+	if lifecyclev1alpha1.IsPaused(oldMachineType) != lifecyclev1alpha1.IsPaused(newMachineType) {
+		r.enqueueMachinesOfType(ctx, newMachineType, q)
+		return
+	}
+	// new available versions might change versions resolved from constraints,
+	// halted rollouts stop installation on admitted machines
+	if reflect.DeepEqual(oldMachineType.Spec.MachineGroups, newMachineType.Spec.MachineGroups) &&
+		reflect.DeepEqual(oldMachineType.Spec.MaintenanceWindows, newMachineType.Spec.MaintenanceWindows) &&
+		reflect.DeepEqual(oldMachineType.Status.AvailablePackages, newMachineType.Status.AvailablePackages) &&
+		reflect.DeepEqual(oldMachineType.Status.Rollouts, newMachineType.Status.Rollouts) {
 		return
 	}
-	for _, group := range newMachineType.Spec.MachineGroups {
-		ls := group.MachineSelector
+	// machines matched by previous groups and maintenance windows are
+	// enqueued as well, since their effective desired packages or windows
+	// might change
+	for _, ls := range slices.Concat(machineSelectors(oldMachineType), machineSelectors(newMachineType)) {
 		selector, err := metav1.LabelSelectorAsSelector(&ls)
 		if err != nil {
 			r.Log.Error(err, "failed to process machinetype update")
@@ -222,94 +369,239 @@ func (r *MachineReconciler) enqueueOnMachineTypeUpdate(
 	}
 }
 
-func (r *MachineReconciler) packagesToInstall(
+// enqueueMachinesOfType enqueues machines referring to the machine type.
+func (r *MachineReconciler) enqueueMachinesOfType(
 	ctx context.Context,
-	obj *lifecyclev1alpha1.Machine,
-) ([]lifecyclev1alpha1.PackageVersion, error) {
-	log := logr.FromContextOrDiscard(ctx)
-	machineType := &lifecyclev1alpha1.MachineType{}
-	key := types.NamespacedName{Name: obj.Spec.MachineTypeRef.Name, Namespace: obj.Namespace}
-	if err := r.Get(ctx, key, machineType); err != nil {
-		log.Error(err, "failed to get referenced machine type object")
-		return nil, err
+	machineType *lifecyclev1alpha1.MachineType,
+	q workqueue.RateLimitingInterface,
+) {
+	machines := &lifecyclev1alpha1.MachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(r.Namespace), client.Limit(1000)); err != nil {
+		r.Log.Error(err, "failed to list machines")
+		return
 	}
-
-	defaultPackages := make([]lifecyclev1alpha1.PackageVersion, 0)
-	for _, entry := range machineType.Spec.MachineGroups {
-		selector := entry.MachineSelector.DeepCopy()
-		groupLabels, err := metav1.LabelSelectorAsMap(selector)
-		if err != nil {
-			log.Error(err, "failed to convert label selector to map")
-			return nil, err
+	for _, item := range machines.Items {
+		if item.Spec.MachineTypeRef.Name != machineType.Name {
+			continue
 		}
-		if machineLabelsCompliant(obj.Labels, groupLabels) {
-			defaultPackages = append(defaultPackages, entry.Packages...)
-			break
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: item.Namespace,
+			Name:      item.Name,
+		}})
+	}
+}
+
+// machineSelectors returns selectors of machine groups and maintenance
+// windows of the machine type.
+func machineSelectors(machineType *lifecyclev1alpha1.MachineType) []metav1.LabelSelector {
+	var selectors []metav1.LabelSelector
+	for _, group := range machineType.Spec.MachineGroups {
+		selectors = append(selectors, group.MachineSelector)
+	}
+	for _, window := range machineType.Spec.MaintenanceWindows {
+		if window.MachineSelector != nil {
+			selectors = append(selectors, *window.MachineSelector)
 		}
 	}
+	return selectors
+}
 
-	resultPackages := filterPackageVersion(obj.Spec.Packages, obj.Status.InstalledPackages, defaultPackages)
-	return resultPackages, nil
+func setVersionUnresolvedCondition(obj *lifecyclev1alpha1.Machine, unresolved []planutil.DesiredPackage) {
+	if len(unresolved) == 0 {
+		meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionVersionUnresolved)
+		return
+	}
+	reasons := make([]string, len(unresolved))
+	for i, pv := range unresolved {
+		reasons[i] = pv.Unresolved
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionVersionUnresolved,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+		Reason:             ReasonNoMatchingVersion,
+		Message:            strings.Join(reasons, "; "),
+	})
 }
 
-func machineLabelsCompliant(machineLabels, selectorLabels map[string]string) bool {
-	for k, sv := range selectorLabels {
-		mv, ok := machineLabels[k]
-		if !ok {
-			return false
-		}
-		if mv != sv {
-			return false
-		}
+func setDowngradeBlockedCondition(obj *lifecyclev1alpha1.Machine, blocked []planutil.DesiredPackage) {
+	if len(blocked) == 0 {
+		meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionDowngradeBlocked)
+		return
 	}
-	return true
+	reasons := make([]string, len(blocked))
+	for i, pv := range blocked {
+		reasons[i] = fmt.Sprintf("package %s: version %s is lower than installed %s",
+			pv.Name, pv.Version, pv.InstalledVersion)
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionDowngradeBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+		Reason:             ReasonDowngradeDenied,
+		Message:            strings.Join(reasons, "; "),
+	})
 }
 
-func filterPackageVersion(
-	desiredPackages, installedPackages, defaultPackages []lifecyclev1alpha1.PackageVersion,
-) []lifecyclev1alpha1.PackageVersion {
-	tempPackages := make([]lifecyclev1alpha1.PackageVersion,
-		len(desiredPackages), len(desiredPackages)+len(defaultPackages))
-	copy(tempPackages, desiredPackages)
+// waitForMaintenanceWindow reports whether installation must wait for
+// maintenance window to open and sets the condition accordingly.
+func waitForMaintenanceWindow(
+	obj *lifecyclev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+	group *lifecyclev1alpha1.MachineGroup,
+) (reconcile.Result, bool) {
+	condition := metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionWaitingForMaintenanceWindow,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+	}
+	result := reconcile.Result{}
+	state, err := windowutil.Check(obj, machineType, group, time.Now())
+	switch {
+	case err != nil:
+		// invalid window might be fixed only by changing machine type, which
+		// triggers reconciliation
+		condition.Reason = ReasonInvalidWindow
+		condition.Message = err.Error()
+	case state.Open:
+		removeWaitingForMaintenanceWindowCondition(obj)
+		return result, false
+	case state.OpensAt.IsZero():
+		condition.Reason = ReasonOutsideWindow
+		condition.Message = "maintenance windows never open"
+	default:
+		condition.Reason = ReasonOutsideWindow
+		condition.Message = fmt.Sprintf("next maintenance window opens at %s", state.OpensAt.Format(time.RFC3339))
+		result.RequeueAfter = time.Until(state.OpensAt)
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, condition)
+	obj.Status.Message = StatusMessageMaintenanceWindowWaiting
+	return result, true
+}
 
-	for _, pv := range defaultPackages {
-		idx := slices.IndexFunc(desiredPackages, func(packageVersion lifecyclev1alpha1.PackageVersion) bool {
-			return pv.Name == packageVersion.Name
-		})
-		if idx >= 0 {
-			continue
+func removeWaitingForMaintenanceWindowCondition(obj *lifecyclev1alpha1.Machine) {
+	meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionWaitingForMaintenanceWindow)
+}
+
+// waitForApproval reports whether installation must wait for approval of
+// pending packages and sets the condition accordingly.
+func (r *MachineReconciler) waitForApproval(
+	ctx context.Context,
+	obj *lifecyclev1alpha1.Machine,
+	plan planutil.Plan,
+) (bool, error) {
+	if !approvalutil.Required(plan.Group) {
+		meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionWaitingForApproval)
+		return false, r.clearApproval(ctx, obj)
+	}
+	approvalutil.Request(obj, plan.Pending, time.Now())
+	if err := r.decideByAnnotation(ctx, obj); err != nil {
+		return false, err
+	}
+	approval := obj.Status.Approval
+	condition := metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionWaitingForApproval,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+	}
+	switch approval.State {
+	case lifecyclev1alpha1.ApprovalStateApproved:
+		meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionWaitingForApproval)
+		return false, nil
+	case lifecyclev1alpha1.ApprovalStateRejected:
+		// rejected installation is not retried until pending packages change
+		condition.Reason = ReasonInstallRejected
+		condition.Message = fmt.Sprintf("installation of revision %s rejected by %s", approval.Revision, approval.User)
+		if approval.Reason != "" {
+			condition.Message += ": " + approval.Reason
 		}
-		tempPackages = append(tempPackages, pv)
+	default:
+		condition.Reason = ReasonAwaitingApproval
+		condition.Message = fmt.Sprintf("installation of revision %s awaits approval", approval.Revision)
 	}
+	meta.SetStatusCondition(&obj.Status.Conditions, condition)
+	obj.Status.Message = StatusMessageApprovalWaiting
+	return true, nil
+}
 
-	resultPackages := make([]lifecyclev1alpha1.PackageVersion, 0, len(tempPackages)+len(installedPackages))
-	for _, pv := range tempPackages {
-		idx := slices.IndexFunc(installedPackages, func(packageVersion lifecyclev1alpha1.PackageVersion) bool {
-			return pv.Name == packageVersion.Name
-		})
-		if idx < 0 {
-			resultPackages = append(resultPackages, pv)
-			continue
-		}
-		if installedPackages[idx].Version != pv.Version {
-			resultPackages = append(resultPackages, pv)
+// decideByAnnotation records the decision made with approval annotations and
+// removes them. Status is updated first, so the decision is not lost if
+// annotations are failed to remove.
+func (r *MachineReconciler) decideByAnnotation(ctx context.Context, obj *lifecyclev1alpha1.Machine) error {
+	log := logr.FromContextOrDiscard(ctx)
+	state, user := lifecyclev1alpha1.ApprovalStateApproved, obj.Annotations[lifecyclev1alpha1.ApprovedByAnnotation]
+	if rejectedBy := obj.Annotations[lifecyclev1alpha1.RejectedByAnnotation]; rejectedBy != "" {
+		state, user = lifecyclev1alpha1.ApprovalStateRejected, rejectedBy
+	}
+	if user == "" {
+		return nil
+	}
+	reason := obj.Annotations[lifecyclev1alpha1.ApprovalReasonAnnotation]
+	err := approvalutil.Decide(obj, state, user, reason, "", time.Now())
+	switch {
+	case errors.Is(err, approvalutil.ErrAlreadyDecided):
+		log.Info("approval annotation ignored", "reason", err.Error())
+	case err != nil:
+		return err
+	default:
+		log.Info("install approval decided",
+			"state", state, "user", user, "revision", obj.Status.Approval.Revision, "reason", reason)
+		if err = r.Status().Patch(ctx, obj, client.Merge); err != nil {
+			return err
 		}
 	}
-	return slices.Clip(resultPackages)
+	modified := obj.DeepCopy()
+	delete(modified.Annotations, lifecyclev1alpha1.ApprovedByAnnotation)
+	delete(modified.Annotations, lifecyclev1alpha1.RejectedByAnnotation)
+	delete(modified.Annotations, lifecyclev1alpha1.ApprovalReasonAnnotation)
+	if err = r.Patch(ctx, modified, client.MergeFrom(obj)); err != nil {
+		return err
+	}
+	obj.Annotations = modified.Annotations
+	obj.ResourceVersion = modified.ResourceVersion
+	return nil
 }
 
-func mergePackageVersion(base, add []lifecyclev1alpha1.PackageVersion) []lifecyclev1alpha1.PackageVersion {
-	result := make([]lifecyclev1alpha1.PackageVersion, len(base), len(base)+len(add))
-	copy(result, base)
-	for _, pv := range add {
-		idx := slices.IndexFunc(base, func(entry lifecyclev1alpha1.PackageVersion) bool {
-			return entry.Name == pv.Name
-		})
-		if idx >= 0 {
-			result[idx] = pv
-			continue
-		}
-		result = append(result, pv)
+// clearApproval removes the approval once no installation awaits it. Merge
+// patch of the object omits empty approval, therefore it is removed with the
+// dedicated patch, which must not overwrite pending status changes.
+func (r *MachineReconciler) clearApproval(ctx context.Context, obj *lifecyclev1alpha1.Machine) error {
+	if obj.Status.Approval == nil {
+		return nil
+	}
+	patched := obj.DeepCopy()
+	patch := client.RawPatch(types.MergePatchType, []byte(`{"status":{"approval":null}}`))
+	if err := r.Status().Patch(ctx, patched, patch); err != nil {
+		return err
+	}
+	obj.Status.Approval = nil
+	obj.ResourceVersion = patched.ResourceVersion
+	return nil
+}
+
+// removeWaitingConditions removes conditions of installation gates, once the
+// installation is not pending or waits for rollout.
+func removeWaitingConditions(obj *lifecyclev1alpha1.Machine) {
+	removeWaitingForMaintenanceWindowCondition(obj)
+	meta.RemoveStatusCondition(&obj.Status.Conditions, lifecyclev1alpha1.MachineConditionWaitingForApproval)
+}
+
+func (r *MachineReconciler) installPlan(
+	ctx context.Context,
+	obj *lifecyclev1alpha1.Machine,
+) (planutil.Plan, *lifecyclev1alpha1.MachineType, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	machineType := &lifecyclev1alpha1.MachineType{}
+	key := types.NamespacedName{Name: obj.Spec.MachineTypeRef.Name, Namespace: obj.Namespace}
+	if err := r.Get(ctx, key, machineType); err != nil {
+		log.Error(err, "failed to get referenced machine type object")
+		return planutil.Plan{}, nil, err
+	}
+
+	plan, err := planutil.Compute(obj, machineType)
+	var conflict *planutil.GroupConflictError
+	if err != nil && !errors.As(err, &conflict) {
+		log.Error(err, "failed to compute install plan")
 	}
-	return slices.Clip(result)
+	return plan, machineType, err
 }
@@ -5,15 +5,20 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/driftutil"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil/fake"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil/mock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
@@ -166,6 +171,418 @@ var _ = Describe("Machine controller", func() {
 				err = machineRec.Get(context.Background(), machineKey, reconciledMachine)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(reconciledMachine.Status.Message).To(Equal(StatusMessageInstallRequestProcessing))
+				Expect(reconciledMachine.Spec.Packages).To(BeEmpty())
+				upToDate := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionUpToDate)
+				Expect(upToDate).NotTo(BeNil())
+				Expect(upToDate.Status).To(Equal(metav1.ConditionFalse))
+				Expect(upToDate.Reason).To(Equal(ReasonPackagesPending))
+				Expect(upToDate.Message).To(Equal("pending packages: bios 1.0.0"))
+				Expect(meta.IsStatusConditionTrue(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionInstalling)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.ConditionServiceReachable)).To(BeTrue())
+				Expect(reconciledMachine.Status.DesiredPackages).To(Equal([]lifecyclev1alpha1.DesiredPackageVersion{{
+					Name:    "bios",
+					Version: "1.0.0",
+					Source:  lifecyclev1alpha1.PackageSourceMachineGroup,
+				}}))
+			})
+		})
+
+		Context("When several machine groups with the same priority match", func() {
+			It("Should report group conflict condition and skip installation", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("group-conflict").
+					WithNamespace("default").
+					WithLabels(map[string]string{"env": "test"}).
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithLastScanTime(metav1.Now()).
+					Complete()
+				Expect(machine).NotTo(BeNil())
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					WithMachineGroups([]lifecyclev1alpha1.MachineGroup{
+						{
+							Name:            "test",
+							MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "test"}},
+							Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+						},
+						{
+							Name: "all",
+							MachineSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "env", Operator: metav1.LabelSelectorOpExists},
+							}},
+							Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+						},
+					}).
+					Complete()
+				Expect(machineType).NotTo(BeNil())
+				machineKey := types.NamespacedName{Namespace: "default", Name: "group-conflict"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				res, err := machineRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machineKey})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(ctrl.Result{}))
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				Expect(reconciledMachine.Status.Message).To(Equal(StatusMessageGroupConflict))
+				Expect(reconciledMachine.Status.DesiredPackages).To(BeEmpty())
+				condition := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionGroupConflict)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonMultipleGroupsMatched))
+				Expect(condition.Message).To(ContainSubstring(`"test", "all"`))
+			})
+		})
+
+		Context("When downgrade is denied by policy", func() {
+			It("Should report downgrade blocked condition and skip installation", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("downgrade-blocked").
+					WithNamespace("default").
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithDesiredPackages(lifecyclev1alpha1.PackageVersion{Name: "bmc", Version: "1.0.0"}).
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bmc", Version: "1.1.0"}).
+					WithLastScanTime(metav1.Now()).
+					Complete()
+				Expect(machine).NotTo(BeNil())
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					Complete()
+				Expect(machineType).NotTo(BeNil())
+				machineKey := types.NamespacedName{Namespace: "default", Name: "downgrade-blocked"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				res, err := machineRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machineKey})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(ctrl.Result{}))
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				Expect(reconciledMachine.Status.Message).To(BeEmpty())
+				condition := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionDowngradeBlocked)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonDowngradeDenied))
+				Expect(condition.Message).To(Equal("package bmc: version 1.0.0 is lower than installed 1.1.0"))
+			})
+		})
+
+		Context("When machine is not admitted to machine group rollout", func() {
+			It("Should skip installation of group packages", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("rollout-waiting").
+					WithNamespace("default").
+					WithLabels(map[string]string{"env": "prod"}).
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"}).
+					WithLastScanTime(metav1.Now()).
+					Complete()
+				Expect(machine).NotTo(BeNil())
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					WithMachineGroups([]lifecyclev1alpha1.MachineGroup{{
+						Name:            "production",
+						MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+						Rollout:         &lifecyclev1alpha1.RolloutStrategy{}}}).
+					Complete()
+				Expect(machineType).NotTo(BeNil())
+				machineKey := types.NamespacedName{Namespace: "default", Name: "rollout-waiting"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				res, err := machineRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machineKey})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(ctrl.Result{}))
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				Expect(reconciledMachine.Status.Message).To(Equal(StatusMessageRolloutWaiting))
+				Expect(reconciledMachine.Status.DesiredPackages).To(HaveLen(1))
+			})
+		})
+
+		Context("When maintenance window of the machine is closed", func() {
+			It("Should skip installation until the window opens", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("window-waiting").
+					WithNamespace("default").
+					WithLabels(map[string]string{"rack": "a"}).
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithDesiredPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"}).
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"}).
+					WithLastScanTime(metav1.Now()).
+					Complete()
+				Expect(machine).NotTo(BeNil())
+				// window opening in the future is closed now
+				opensAt := time.Now().UTC().Add(2 * time.Hour)
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					WithMaintenanceWindows([]lifecyclev1alpha1.MaintenanceWindow{{
+						Name:            "rack-a",
+						Schedule:        fmt.Sprintf("%d %d * * *", opensAt.Minute(), opensAt.Hour()),
+						Duration:        metav1.Duration{Duration: time.Hour},
+						MachineSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rack": "a"}}}}).
+					Complete()
+				Expect(machineType).NotTo(BeNil())
+				machineKey := types.NamespacedName{Namespace: "default", Name: "window-waiting"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				res, err := machineRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machineKey})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.RequeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				Expect(reconciledMachine.Status.Message).To(Equal(StatusMessageMaintenanceWindowWaiting))
+				condition := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionWaitingForMaintenanceWindow)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(ReasonOutsideWindow))
+			})
+		})
+
+		Context("When installation requires approval", func() {
+			It("Should skip installation until it is approved with annotation", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("approval-waiting").
+					WithNamespace("default").
+					WithLabels(map[string]string{"env": "prod"}).
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"}).
+					WithLastScanTime(metav1.Now()).
+					Complete()
+				Expect(machine).NotTo(BeNil())
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					WithMachineGroups([]lifecyclev1alpha1.MachineGroup{{
+						Name:            "production",
+						MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+						Approval:        lifecyclev1alpha1.ApprovalModeRequired}}).
+					Complete()
+				Expect(machineType).NotTo(BeNil())
+				machineKey := types.NamespacedName{Namespace: "default", Name: "approval-waiting"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				req := ctrl.Request{NamespacedName: machineKey}
+				_, err := machineRec.Reconcile(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				Expect(reconciledMachine.Status.Message).To(Equal(StatusMessageApprovalWaiting))
+				Expect(reconciledMachine.Status.Approval).NotTo(BeNil())
+				Expect(reconciledMachine.Status.Approval.State).To(Equal(lifecyclev1alpha1.ApprovalStatePending))
+				Expect(reconciledMachine.Status.Approval.Packages).To(Equal(
+					[]lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}))
+				condition := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionWaitingForApproval)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Reason).To(Equal(ReasonAwaitingApproval))
+
+				reconciledMachine.Annotations = map[string]string{
+					lifecyclev1alpha1.ApprovedByAnnotation:     "alice",
+					lifecyclev1alpha1.ApprovalReasonAnnotation: "change approved",
+				}
+				Expect(machineRec.Update(context.Background(), reconciledMachine)).To(Succeed())
+				_, err = machineRec.Reconcile(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				approvedMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, approvedMachine)).To(Succeed())
+				Expect(approvedMachine.Annotations).NotTo(HaveKey(lifecyclev1alpha1.ApprovedByAnnotation))
+				Expect(approvedMachine.Annotations).NotTo(HaveKey(lifecyclev1alpha1.ApprovalReasonAnnotation))
+				Expect(approvedMachine.Status.Approval.State).To(Equal(lifecyclev1alpha1.ApprovalStateApproved))
+				Expect(approvedMachine.Status.Approval.User).To(Equal("alice"))
+				Expect(approvedMachine.Status.Approval.Reason).To(Equal("change approved"))
+				Expect(approvedMachine.Status.ApprovalHistory).To(HaveLen(1))
+				Expect(meta.FindStatusCondition(approvedMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionWaitingForApproval)).To(BeNil())
+			})
+		})
+
+		Context("When firmware of the machine drifted", func() {
+			drifted := metav1.Condition{
+				Type:               lifecyclev1alpha1.MachineConditionFirmwareDrift,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "OutOfBandChange",
+				Message:            "package bios: 2.0.0 -> 1.0.0 (desired 2.0.0)",
+			}
+			reconcileDrifted := func(name string, policy lifecyclev1alpha1.DriftPolicy) *lifecyclev1alpha1.Machine {
+				machine := mock.NewUnstructuredBuilder().
+					WithName(name).
+					WithNamespace("default").
+					WithLabels(map[string]string{"env": "prod"}).
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"}).
+					WithLastScanTime(metav1.Now()).
+					WithConditions(drifted).
+					Complete()
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					WithMachineGroups([]lifecyclev1alpha1.MachineGroup{{
+						Name:            "production",
+						MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+						DriftPolicy:     policy}}).
+					Complete()
+				machineKey := types.NamespacedName{Namespace: "default", Name: name}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				_, err := machineRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machineKey})
+				Expect(err).NotTo(HaveOccurred())
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				return reconciledMachine
+			}
+
+			It("Should skip installation if machine group only alerts", func() {
+				machine := reconcileDrifted("drift-alert", lifecyclev1alpha1.DriftPolicyAlert)
+				Expect(machine.Status.Message).To(Equal(StatusMessageDriftDetected))
+				Expect(meta.IsStatusConditionTrue(machine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionFirmwareDrift)).To(BeTrue())
+			})
+
+			It("Should keep alert on changes of packages, which are not managed", func() {
+				drifted.Reason = driftutil.ReasonUnmanagedChange
+				DeferCleanup(func() { drifted.Reason = "OutOfBandChange" })
+				machine := reconcileDrifted("drift-unmanaged", lifecyclev1alpha1.DriftPolicyAlert)
+				Expect(machine.Status.Message).NotTo(Equal(StatusMessageDriftDetected))
+				Expect(meta.IsStatusConditionTrue(machine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionFirmwareDrift)).To(BeTrue())
+			})
+
+			It("Should install desired packages again by default", func() {
+				machine := reconcileDrifted("drift-reconverge", "")
+				Expect(machine.Status.Message).To(Equal(StatusMessageInstallRequestProcessing))
+				Expect(meta.IsStatusConditionTrue(machine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionFirmwareDrift)).To(BeTrue())
+			})
+		})
+
+		Context("When desired packages are installed", func() {
+			It("Should report the machine up to date", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("up-to-date").
+					WithNamespace("default").
+					WithLabels(map[string]string{"env": "prod"}).
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"}).
+					WithLastScanTime(metav1.Now()).
+					Complete()
+				machine.Generation = 3
+				machine.Status.LastScanResult = lifecyclev1alpha1.ScanSuccess
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					WithMachineGroups([]lifecyclev1alpha1.MachineGroup{{
+						MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}}}).
+					Complete()
+				machineKey := types.NamespacedName{Namespace: "default", Name: "up-to-date"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				_, err := machineRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machineKey})
+				Expect(err).NotTo(HaveOccurred())
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				upToDate := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionUpToDate)
+				Expect(upToDate).NotTo(BeNil())
+				Expect(upToDate.Status).To(Equal(metav1.ConditionTrue))
+				Expect(upToDate.Reason).To(Equal(ReasonPackagesInstalled))
+				Expect(upToDate.ObservedGeneration).To(Equal(int64(3)))
+				Expect(upToDate.LastTransitionTime.IsZero()).To(BeFalse())
+				Expect(meta.IsStatusConditionFalse(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionInstalling)).To(BeTrue())
+				Expect(meta.IsStatusConditionTrue(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.ConditionScanned)).To(BeTrue())
+				Expect(meta.IsStatusConditionFalse(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.ConditionScanFailed)).To(BeTrue())
+			})
+		})
+
+		Context("When machine type is paused", func() {
+			It("Should skip scan of the machine until the machine type is resumed", func() {
+				machine := mock.NewUnstructuredBuilder().
+					WithName("paused").
+					WithNamespace("default").
+					MachineFromUnstructured().WithMachineTypeRef("sample").
+					Complete()
+				Expect(machine).NotTo(BeNil())
+				machineType := mock.NewUnstructuredBuilder().
+					WithName("sample").WithNamespace("default").MachineTypeFromUnstructured().
+					Complete()
+				Expect(machineType).NotTo(BeNil())
+				machineType.Annotations = map[string]string{lifecyclev1alpha1.PausedAnnotation: "true"}
+				machineKey := types.NamespacedName{Namespace: "default", Name: "paused"}
+				s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+				c := testutil.SetupClient(s,
+					testutil.WithRuntimeObject(machine),
+					testutil.WithRuntimeObject(machineType))
+				machineRec := NewMachineReconciler(c, s)
+				machineRec.MachineServiceClient = fake.NewMachineClient()
+				req := ctrl.Request{NamespacedName: machineKey}
+				res, err := machineRec.Reconcile(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res).To(Equal(ctrl.Result{}))
+
+				pausedMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, pausedMachine)).To(Succeed())
+				Expect(pausedMachine.Status.Message).To(Equal(StatusMessagePaused))
+				condition := meta.FindStatusCondition(pausedMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionPaused)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Reason).To(Equal(ReasonMachineTypePaused))
+
+				machineTypeKey := types.NamespacedName{Namespace: "default", Name: "sample"}
+				Expect(machineRec.Get(context.Background(), machineTypeKey, machineType)).To(Succeed())
+				machineType.Annotations = nil
+				Expect(machineRec.Update(context.Background(), machineType)).To(Succeed())
+				_, err = machineRec.Reconcile(context.Background(), req)
+				Expect(err).NotTo(HaveOccurred())
+
+				resumedMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, resumedMachine)).To(Succeed())
+				Expect(resumedMachine.Status.Message).To(Equal(StatusMessageScanRequestProcessing))
+				Expect(meta.FindStatusCondition(resumedMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionPaused)).To(BeNil())
 			})
 		})
 
@@ -199,6 +616,22 @@ var _ = Describe("Machine controller", func() {
 				res, err := machineRec.Reconcile(context.Background(), req)
 				Expect(err).To(HaveOccurred())
 				Expect(res).To(Equal(ctrl.Result{}))
+
+				reconciledMachine := &lifecyclev1alpha1.Machine{}
+				Expect(machineRec.Get(context.Background(), machineKey, reconciledMachine)).To(Succeed())
+				reachable := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.ConditionServiceReachable)
+				Expect(reachable).NotTo(BeNil())
+				Expect(reachable.Status).To(Equal(metav1.ConditionFalse))
+				Expect(reachable.Reason).To(Equal(ReasonRequestFailed))
+				installing := meta.FindStatusCondition(reconciledMachine.Status.Conditions,
+					lifecyclev1alpha1.MachineConditionInstalling)
+				Expect(installing).NotTo(BeNil())
+				Expect(installing.Status).To(Equal(metav1.ConditionFalse))
+				Expect(installing.Reason).To(Equal(ReasonInstallRequestFailed))
+				events := machineRec.Recorder.(*record.FakeRecorder).Events
+				Expect(events).To(HaveLen(1))
+				Expect(<-events).To(HavePrefix("Warning RequestFailed install request failed:"))
 			})
 		})
 	})
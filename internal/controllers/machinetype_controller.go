@@ -5,20 +5,28 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machinetype/v1alpha1/machinetypev1alpha1connect"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	machinetypev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machinetype/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/rolloututil"
 )
 
 // MachineTypeReconciler reconciles a MachineType object.
@@ -28,13 +36,17 @@ type MachineTypeReconciler struct {
 
 	Horizon time.Duration
 
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machinetypes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machinetypes/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machinetypes/finalizers,verbs=update
+// +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machinetypes/scan,verbs=create
+// +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machines,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=ironcore.dev,resources=oobs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ironcore.dev,resources=oobs/status,verbs=get;list;watch
 
@@ -44,6 +56,9 @@ func (r *MachineTypeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	obj := &lifecyclev1alpha1.MachineType{}
 	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			setPausedMetric(kindMachineType, req.Namespace, req.Name, false)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
@@ -78,6 +93,43 @@ func (r *MachineTypeReconciler) reconcileRequired(
 func (r *MachineTypeReconciler) reconcile(
 	ctx context.Context,
 	obj *lifecyclev1alpha1.MachineType,
+) (reconcile.Result, error) {
+	status := &obj.Status
+	setScanConditions(&status.Conditions, obj.Generation, status.LastScanResult, status.LastScanTime)
+	// paused machine type neither scans nor admits machines to rollouts,
+	// its machines are paused by machine controller
+	paused := lifecyclev1alpha1.IsPaused(obj)
+	setPausedMetric(kindMachineType, obj.Namespace, obj.Name, paused)
+	if paused {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               lifecyclev1alpha1.MachineTypeConditionPaused,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: obj.Generation,
+			Reason:             ReasonMachineTypePaused,
+			Message:            fmt.Sprintf("machine type is annotated with %s", lifecyclev1alpha1.PausedAnnotation),
+		})
+		obj.Status.Message = StatusMessagePaused
+		return reconcile.Result{}, nil
+	}
+	meta.RemoveStatusCondition(&status.Conditions, lifecyclev1alpha1.MachineTypeConditionPaused)
+	rolloutResult, err := r.rollout(ctx, obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	result, err := r.reconcileScan(ctx, obj)
+	if err != nil {
+		return result, err
+	}
+	if rolloutResult.RequeueAfter > 0 &&
+		(result.RequeueAfter == 0 || rolloutResult.RequeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = rolloutResult.RequeueAfter
+	}
+	return result, nil
+}
+
+func (r *MachineTypeReconciler) reconcileScan(
+	ctx context.Context,
+	obj *lifecyclev1alpha1.MachineType,
 ) (reconcile.Result, error) {
 	if obj.Status.LastScanTime.IsZero() {
 		return r.scan(ctx, obj)
@@ -100,8 +152,10 @@ func (r *MachineTypeReconciler) scan(
 	}))
 	if err != nil {
 		log.Error(err, "failed to send scan request")
+		reportRequestFailure(ctx, r.Client, r.Recorder, obj, &obj.Status.Conditions, "scan", err)
 		return reconcile.Result{}, err
 	}
+	setServiceReachableCondition(&obj.Status.Conditions, obj.Generation, nil)
 	scanResponse := resp.Msg
 	result := reconcile.Result{}
 	switch {
@@ -116,6 +170,96 @@ func (r *MachineTypeReconciler) scan(
 	return result, nil
 }
 
+// rollout advances rollouts of machine groups having rollout strategy and
+// admits the next batch of machines by annotating them with the revision of
+// the group.
+func (r *MachineTypeReconciler) rollout(
+	ctx context.Context,
+	obj *lifecyclev1alpha1.MachineType,
+) (reconcile.Result, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	result := reconcile.Result{}
+	var rollouts []lifecyclev1alpha1.MachineGroupRolloutStatus
+	for i := range obj.Spec.MachineGroups {
+		group := &obj.Spec.MachineGroups[i]
+		if group.Name == "" || group.Rollout == nil {
+			continue
+		}
+		machines, err := r.groupMachines(ctx, obj, group)
+		if err != nil {
+			log.Error(err, "failed to list machines of the group", "group", group.Name)
+			return reconcile.Result{}, err
+		}
+		revision := rolloututil.Revision(group)
+		states := make([]rolloututil.Machine, 0, len(machines))
+		for _, machine := range machines {
+			states = append(states, rolloututil.NewMachine(&machine.Machine, machine.Plan, revision))
+		}
+		step := rolloututil.Step(group, rolloututil.Find(obj.Status.Rollouts, group.Name), states, time.Now())
+		for _, name := range step.Admit {
+			if err = r.admit(ctx, obj.Namespace, name, revision); err != nil {
+				log.Error(err, "failed to admit machine", "group", group.Name, "machine", name)
+				return reconcile.Result{}, err
+			}
+		}
+		if step.RequeueAfter > 0 && (result.RequeueAfter == 0 || step.RequeueAfter < result.RequeueAfter) {
+			result.RequeueAfter = step.RequeueAfter
+		}
+		rollouts = append(rollouts, step.Status)
+	}
+	obj.Status.Rollouts = rollouts
+	return result, nil
+}
+
+type groupMachine struct {
+	lifecyclev1alpha1.Machine
+	Plan planutil.Plan
+}
+
+// groupMachines returns machines of the machine type, for which the group is
+// the effective machine group, along with their install plans.
+func (r *MachineTypeReconciler) groupMachines(
+	ctx context.Context,
+	obj *lifecyclev1alpha1.MachineType,
+	group *lifecyclev1alpha1.MachineGroup,
+) ([]groupMachine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&group.MachineSelector)
+	if err != nil {
+		return nil, err
+	}
+	machines := &lifecyclev1alpha1.MachineList{}
+	if err = r.List(ctx, machines, client.InNamespace(obj.Namespace), client.MatchingLabelsSelector{
+		Selector: selector,
+	}); err != nil {
+		return nil, err
+	}
+	var result []groupMachine
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.Spec.MachineTypeRef.Name != obj.Name {
+			continue
+		}
+		// machines matching groups with the same priority are reported
+		// by machine controller and not rolled out
+		plan, err := planutil.Compute(machine, obj)
+		if err != nil || plan.Group == nil || plan.Group.Name != group.Name {
+			continue
+		}
+		result = append(result, groupMachine{Machine: *machine, Plan: plan})
+	}
+	return result, nil
+}
+
+func (r *MachineTypeReconciler) admit(ctx context.Context, namespace, name, revision string) error {
+	machine := &lifecyclev1alpha1.Machine{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, machine); err != nil {
+		return err
+	}
+	base := machine.DeepCopy()
+	metav1.SetMetaDataAnnotation(&machine.ObjectMeta, lifecyclev1alpha1.RolloutRevisionAnnotation, revision)
+	return r.Patch(ctx, machine, client.MergeFrom(base))
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MachineTypeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -123,6 +267,19 @@ func (r *MachineTypeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 10,
 		}).
+		// progress of rollouts depends on state of machines
+		Watches(&lifecyclev1alpha1.Machine{}, handler.EnqueueRequestsFromMapFunc(machineTypeOfMachine)).
 		Named("machinetype").
 		Complete(r)
 }
+
+func machineTypeOfMachine(_ context.Context, obj client.Object) []reconcile.Request {
+	machine, ok := obj.(*lifecyclev1alpha1.Machine)
+	if !ok || machine.Spec.MachineTypeRef.Name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: machine.Namespace,
+		Name:      machine.Spec.MachineTypeRef.Name,
+	}}}
+}
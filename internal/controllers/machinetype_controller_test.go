@@ -5,12 +5,14 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil/fake"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil/mock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -72,6 +74,55 @@ var _ = Describe("MachineType controller", func() {
 			err = machinetypeRec.Get(context.Background(), machinetypeKey, reconciledMachineType)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(reconciledMachineType.Status.Message).To(Equal(StatusMessageScanRequestSuccessful))
+			Expect(meta.IsStatusConditionTrue(reconciledMachineType.Status.Conditions,
+				lifecyclev1alpha1.ConditionServiceReachable)).To(BeTrue())
+			scanned := meta.FindStatusCondition(reconciledMachineType.Status.Conditions,
+				lifecyclev1alpha1.ConditionScanned)
+			Expect(scanned).NotTo(BeNil())
+			Expect(scanned.Status).To(Equal(metav1.ConditionFalse))
+			Expect(scanned.Reason).To(Equal(ReasonNotScanned))
+		})
+	})
+
+	Context("When MachineType object is paused", func() {
+		It("Should skip scan and report paused state", func() {
+			machinetypeKey := types.NamespacedName{Namespace: "default", Name: "sample-paused"}
+			machineType := mock.NewUnstructuredBuilder().
+				WithName("sample-paused").
+				WithNamespace("default").
+				MachineTypeFromUnstructured().Complete()
+			Expect(machineType).NotTo(BeNil())
+			machineType.Annotations = map[string]string{lifecyclev1alpha1.PausedAnnotation: "true"}
+			s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+			c := testutil.SetupClient(s, testutil.WithRuntimeObject(machineType))
+			// scan request would fail with no service client
+			machinetypeRec := NewMachineTypeReconciler(c, s)
+			req := ctrl.Request{NamespacedName: machinetypeKey}
+			res, err := machinetypeRec.Reconcile(context.Background(), req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(ctrl.Result{}))
+			reconciledMachineType := &lifecyclev1alpha1.MachineType{}
+			Expect(machinetypeRec.Get(context.Background(), machinetypeKey, reconciledMachineType)).To(Succeed())
+			Expect(reconciledMachineType.Status.Message).To(Equal(StatusMessagePaused))
+			Expect(reconciledMachineType.Status.LastScanTime.IsZero()).To(BeTrue())
+			paused := meta.FindStatusCondition(reconciledMachineType.Status.Conditions,
+				lifecyclev1alpha1.MachineTypeConditionPaused)
+			Expect(paused).NotTo(BeNil())
+			Expect(paused.Status).To(Equal(metav1.ConditionTrue))
+			Expect(paused.Reason).To(Equal(ReasonMachineTypePaused))
+			Expect(paused.ObservedGeneration).To(Equal(reconciledMachineType.Generation))
+
+			// resumed machine type is scanned recently, so no scan is requested
+			machinetypeRec.Horizon = time.Hour
+			delete(reconciledMachineType.Annotations, lifecyclev1alpha1.PausedAnnotation)
+			Expect(c.Update(context.Background(), reconciledMachineType)).To(Succeed())
+			reconciledMachineType.Status.LastScanTime = metav1.Now()
+			Expect(c.Status().Update(context.Background(), reconciledMachineType)).To(Succeed())
+			_, err = machinetypeRec.Reconcile(context.Background(), req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machinetypeRec.Get(context.Background(), machinetypeKey, reconciledMachineType)).To(Succeed())
+			Expect(meta.FindStatusCondition(reconciledMachineType.Status.Conditions,
+				lifecyclev1alpha1.MachineTypeConditionPaused)).To(BeNil())
 		})
 	})
 
@@ -92,6 +143,65 @@ var _ = Describe("MachineType controller", func() {
 			res, err := machinetypeRec.Reconcile(context.Background(), req)
 			Expect(err).To(HaveOccurred())
 			Expect(res).To(Equal(ctrl.Result{}))
+			reconciledMachineType := &lifecyclev1alpha1.MachineType{}
+			Expect(machinetypeRec.Get(context.Background(), machinetypeKey, reconciledMachineType)).To(Succeed())
+			Expect(meta.IsStatusConditionFalse(reconciledMachineType.Status.Conditions,
+				lifecyclev1alpha1.ConditionServiceReachable)).To(BeTrue())
+		})
+	})
+
+	Context("When machine group defines rollout strategy", func() {
+		It("Should admit the first batch of machines and report rollout progress", func() {
+			machinetypeKey := types.NamespacedName{Namespace: "default", Name: "sample-rollout"}
+			group := lifecyclev1alpha1.MachineGroup{
+				Name:            "production",
+				MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+				Rollout:         &lifecyclev1alpha1.RolloutStrategy{CanaryBatchSize: 1},
+			}
+			machineType := mock.NewUnstructuredBuilder().
+				WithName("sample-rollout").
+				WithNamespace("default").
+				MachineTypeFromUnstructured().
+				WithMachineGroups([]lifecyclev1alpha1.MachineGroup{group}).
+				Complete()
+			Expect(machineType).NotTo(BeNil())
+			newMachine := func(name string) *lifecyclev1alpha1.Machine {
+				return mock.NewUnstructuredBuilder().
+					WithName(name).
+					WithNamespace("default").
+					WithLabels(map[string]string{"env": "prod"}).
+					MachineFromUnstructured().
+					WithMachineTypeRef("sample-rollout").
+					WithInstalledPackages(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"}).
+					Complete()
+			}
+			s := testutil.SetupScheme(testutil.WithGroupVersion(lifecyclev1alpha1.AddToScheme))
+			c := testutil.SetupClient(s, testutil.WithRuntimeObject(machineType),
+				testutil.WithRuntimeObject(newMachine("machine-a")), testutil.WithRuntimeObject(newMachine("machine-b")))
+			machinetypeRec := NewMachineTypeReconciler(c, s)
+			machinetypeRec.MachineTypeServiceClient = fake.NewMachineTypeClient()
+			_, err := machinetypeRec.Reconcile(context.Background(), ctrl.Request{NamespacedName: machinetypeKey})
+			Expect(err).NotTo(HaveOccurred())
+
+			reconciledMachineType := &lifecyclev1alpha1.MachineType{}
+			Expect(machinetypeRec.Get(context.Background(), machinetypeKey, reconciledMachineType)).To(Succeed())
+			Expect(reconciledMachineType.Status.Rollouts).To(HaveLen(1))
+			rollout := reconciledMachineType.Status.Rollouts[0]
+			Expect(rollout.Name).To(Equal("production"))
+			Expect(rollout.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseProgressing))
+			Expect(rollout.Batch).To(Equal(int32(1)))
+			Expect(rollout.Machines).To(Equal(int32(2)))
+			Expect(rollout.Installing).To(Equal(int32(1)))
+
+			admitted := &lifecyclev1alpha1.Machine{}
+			Expect(machinetypeRec.Get(context.Background(),
+				types.NamespacedName{Namespace: "default", Name: "machine-a"}, admitted)).To(Succeed())
+			Expect(admitted.Annotations).To(HaveKeyWithValue(lifecyclev1alpha1.RolloutRevisionAnnotation, rollout.Revision))
+			waiting := &lifecyclev1alpha1.Machine{}
+			Expect(machinetypeRec.Get(context.Background(),
+				types.NamespacedName{Namespace: "default", Name: "machine-b"}, waiting)).To(Succeed())
+			Expect(waiting.Annotations).NotTo(HaveKey(lifecyclev1alpha1.RolloutRevisionAnnotation))
 		})
 	})
 })
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	kindMachine     = "Machine"
+	kindMachineType = "MachineType"
+)
+
+// pausedObjects reports objects, which lifecycle operations are paused for.
+var pausedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "lifecycle_paused",
+	Help: "Whether lifecycle operations of the object are paused (1) or not.",
+}, []string{"kind", "namespace", "name"})
+
+func init() {
+	metrics.Registry.MustRegister(pausedObjects)
+}
+
+func setPausedMetric(kind, namespace, name string, paused bool) {
+	if paused {
+		pausedObjects.WithLabelValues(kind, namespace, name).Set(1)
+		return
+	}
+	pausedObjects.DeleteLabelValues(kind, namespace, name)
+}
@@ -15,6 +15,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -27,13 +28,15 @@ import (
 type OnboardingReconciler struct {
 	client.Client
 
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 
 	RequeuePeriod time.Duration
 	ScanPeriod    metav1.Duration
 }
 
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machinetypes,verbs=get;list;create
 // +kubebuilder:rbac:groups=lifecycle.ironcore.dev,resources=machines,verbs=get;list;create
 // +kubebuilder:rbac:groups=onmetal.de,resources=oobs,verbs=watch;get;list
@@ -113,6 +116,8 @@ func (r *OnboardingReconciler) onboardMachineType(ctx context.Context, obj *oobv
 		return err
 	}
 	log.V(1).Info("machineType onboarded successfully")
+	r.Recorder.Eventf(machineType, corev1.EventTypeNormal, v1alpha1.EventReasonOnboarded,
+		"machine type onboarded from OOB %s", obj.Name)
 	return nil
 }
 
@@ -142,6 +147,8 @@ func (r *OnboardingReconciler) onboardMachine(ctx context.Context, obj *oobv1alp
 		return err
 	}
 	log.V(1).Info("machine onboarded successfully")
+	r.Recorder.Eventf(machine, corev1.EventTypeNormal, v1alpha1.EventReasonOnboarded,
+		"machine onboarded from OOB %s", obj.Name)
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -117,6 +118,10 @@ var _ = Describe("Onboarding controller", func() {
 			err = onboardingRec.Get(context.Background(), expectedMachineKey, onboardedMachine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(client.ObjectKeyFromObject(onboardedMachine)).To(Equal(expectedMachineKey))
+			events := onboardingRec.Recorder.(*record.FakeRecorder).Events
+			Expect(events).To(HaveLen(2))
+			Expect(<-events).To(Equal("Normal Onboarded machine type onboarded from OOB sample"))
+			Expect(<-events).To(Equal("Normal Onboarded machine onboarded from OOB sample"))
 		})
 	})
 })
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	// +kubebuilder:scaffold:imports
 
@@ -29,21 +30,24 @@ func NewOnboardingReconciler(c client.Client, s *runtime.Scheme) *OnboardingReco
 	return &OnboardingReconciler{
 		Client:        c,
 		Scheme:        s,
+		Recorder:      record.NewFakeRecorder(100),
 		RequeuePeriod: time.Second * 5,
 	}
 }
 
 func NewMachineTypeReconciler(c client.Client, s *runtime.Scheme) *MachineTypeReconciler {
 	return &MachineTypeReconciler{
-		Client: c,
-		Scheme: s,
+		Client:   c,
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(100),
 	}
 }
 
 func NewMachineReconciler(c client.Client, s *runtime.Scheme) *MachineReconciler {
 	return &MachineReconciler{
-		Client:  c,
-		Scheme:  s,
-		Horizon: time.Hour,
+		Client:   c,
+		Scheme:   s,
+		Recorder: record.NewFakeRecorder(100),
+		Horizon:  time.Hour,
 	}
 }
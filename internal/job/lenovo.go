@@ -6,6 +6,7 @@ package job
 import (
 	"context"
 
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
 	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/convertutil"
@@ -48,3 +49,26 @@ func (w *MachineLifecycleWorker) LenovoScanMachine(ctx context.Context, machine
 	machine.Status.LastScanResult = commonv1alpha1.ScanResult_SCAN_RESULT_SUCCESS
 	return nil
 }
+
+func (w *MachineLifecycleWorker) LenovoInstallPackage(
+	ctx context.Context,
+	machine *machinev1alpha1.Machine,
+	pv lifecyclev1alpha1.PackageVersion,
+) error {
+	oob := &oobv1alpha1.OOB{}
+	key := types.NamespacedName{
+		Namespace: machine.ObjectMeta.Namespace,
+		Name:      machine.Spec.OobMachineRef.Name,
+	}
+	if err := w.Get(ctx, key, oob); err != nil {
+		return err
+	}
+
+	// todo:
+	//  1. download the package from firmware storage, report Downloading state with w.setPackageState
+	//  2. implement execution of onecli app with args to flash the package, report Installing state
+	//  3. return error if onecli reports failure
+	//  4. report AwaitingReboot state if the package takes effect after reboot
+	w.log.Info("package installed", "package", pv.Name, "version", pv.Version)
+	return nil
+}
@@ -7,28 +7,42 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
 	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/apiutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/approvalutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/dependencyutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/rolloututil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/versionutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type MachineLifecycleWorker struct {
 	machinev1alpha1connect.MachineServiceClient
 	client.Client
-	log   *slog.Logger
-	jobID string
+	recorder record.EventRecorder
+	log      *slog.Logger
+	jobID    string
 }
 
 func NewMachineLifecycleWorker(opts Options) *MachineLifecycleWorker {
 	return &MachineLifecycleWorker{
-		log:    opts.Log,
-		jobID:  opts.JobID,
-		Client: opts.KubeClient,
+		log:      opts.Log,
+		jobID:    opts.JobID,
+		Client:   opts.KubeClient,
+		recorder: opts.Recorder,
 	}
 }
 
@@ -39,9 +53,8 @@ func (w *MachineLifecycleWorker) WithClient(c machinev1alpha1connect.MachineServ
 
 func (w *MachineLifecycleWorker) Start(ctx context.Context) error {
 	var (
-		getJobResponse              *connect.Response[machinev1alpha1.GetJobResponse]
-		updateMachineStatusResponse *connect.Response[machinev1alpha1.UpdateMachineStatusResponse]
-		err                         error
+		getJobResponse *connect.Response[machinev1alpha1.GetJobResponse]
+		err            error
 	)
 	getJobResponse, err = w.GetJob(ctx, connect.NewRequest(&machinev1alpha1.GetJobRequest{Id: w.jobID}))
 	if err != nil {
@@ -50,16 +63,51 @@ func (w *MachineLifecycleWorker) Start(ctx context.Context) error {
 	}
 	task := getJobResponse.Msg
 	target := task.Target
+	w.recordStarted(target, task.JobType)
 	switch task.JobType {
 	case "scan":
-		err = w.scan(ctx, target)
+		if err = w.scan(ctx, target); err == nil {
+			w.completeRebootedPackages(ctx, target)
+		}
 	case "install":
+		// failures are reported in machine's status, so rollouts of the
+		// machine group might be halted
+		if err = w.refreshTarget(ctx, target); err != nil {
+			w.log.Error("error getting machine", "error", err)
+			return err
+		}
 		err = w.install(ctx, target)
+		setInstallFailedCondition(target.GetStatus(), target.ObjectMeta.Generation, err)
+		if err != nil {
+			w.log.Error("error installing packages", "error", err)
+			if updateErr := w.updateMachineStatus(ctx, target); updateErr != nil {
+				w.log.Error("error updating machine status", "error", updateErr)
+			}
+			return err
+		}
 	}
 	if err != nil {
 		return err
 	}
-	updateMachineStatusResponse, err = w.UpdateMachineStatus(ctx, connect.NewRequest(
+	return w.updateMachineStatus(ctx, target)
+}
+
+// recordStarted reports the job started with the event of the machine, its
+// result is reported by lifecycle-service once the status is updated.
+func (w *MachineLifecycleWorker) recordStarted(target *machinev1alpha1.Machine, jobType string) {
+	if w.recorder == nil || target.GetObjectMeta() == nil {
+		return
+	}
+	reason := lifecyclev1alpha1.EventReasonScanStarted
+	if jobType == "install" {
+		reason = lifecyclev1alpha1.EventReasonInstallStarted
+	}
+	machine := &lifecyclev1alpha1.Machine{ObjectMeta: *target.GetObjectMeta()}
+	w.recorder.Eventf(machine, corev1.EventTypeNormal, reason, "%s job %s started", jobType, w.jobID)
+}
+
+func (w *MachineLifecycleWorker) updateMachineStatus(ctx context.Context, target *machinev1alpha1.Machine) error {
+	updateMachineStatusResponse, err := w.UpdateMachineStatus(ctx, connect.NewRequest(
 		&machinev1alpha1.UpdateMachineStatusRequest{
 			Name:      target.ObjectMeta.Name,
 			Namespace: target.ObjectMeta.Namespace,
@@ -74,24 +122,354 @@ func (w *MachineLifecycleWorker) Start(ctx context.Context) error {
 	return nil
 }
 
+// refreshTarget replaces metadata, spec and status of the machine taken when
+// the job was scheduled with the current ones read from API server. Service's
+// cache might lag behind the status update made right before installation is
+// requested, and desired packages might change while the job is queued.
+func (w *MachineLifecycleWorker) refreshTarget(ctx context.Context, target *machinev1alpha1.Machine) error {
+	machine := &lifecyclev1alpha1.Machine{}
+	key := types.NamespacedName{Namespace: target.ObjectMeta.Namespace, Name: target.ObjectMeta.Name}
+	if err := w.Get(ctx, key, machine); err != nil {
+		return err
+	}
+	fresh := apiutil.MachineToGrpcAPI(machine)
+	target.ObjectMeta = fresh.ObjectMeta
+	target.Spec = fresh.Spec
+	target.Status = fresh.Status
+	return nil
+}
+
 func (w *MachineLifecycleWorker) scan(ctx context.Context, target *machinev1alpha1.Machine) error {
+	machineType, err := w.getMachineType(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	switch machineType.Spec.Manufacturer {
+	case "Lenovo":
+		return w.LenovoScanMachine(ctx, target)
+	}
+
+	return nil
+}
+
+func (w *MachineLifecycleWorker) getMachineType(
+	ctx context.Context,
+	target *machinev1alpha1.Machine,
+) (*lifecyclev1alpha1.MachineType, error) {
 	machineType := &lifecyclev1alpha1.MachineType{}
 	key := types.NamespacedName{
 		Namespace: target.ObjectMeta.Namespace,
 		Name:      target.Spec.MachineTypeRef.Name,
 	}
 	if err := w.Get(ctx, key, machineType); err != nil {
+		return nil, err
+	}
+	return machineType, nil
+}
+
+// install installs effective desired packages resolved by the controller
+// into machine's status, which versions differ from installed ones.
+// Packages are installed after packages they depend on, installation stops
+// on the first failure. Applied packages are reflected in installed packages
+// of machine's status, so they are reported even if installation fails.
+func (w *MachineLifecycleWorker) install(ctx context.Context, target *machinev1alpha1.Machine) error {
+	pending := pendingPackages(target.GetStatus())
+	if len(pending) == 0 {
+		w.log.Info("no packages to install")
+		return nil
+	}
+	machineType, err := w.getMachineType(ctx, target)
+	if err != nil {
+		return err
+	}
+	installable, err := w.installablePackages(target, machineType, pending)
+	if err != nil {
+		return err
+	}
+	scheme := versionutil.ForManufacturer(machineType.Spec.Manufacturer)
+	installed := apiutil.PackageVersionsToKubeAPI(target.GetStatus().GetInstalledPackages())
+	dependencies := dependencyutil.Dependencies(machineType.Status.AvailablePackages)
+	ordered, err := dependencyutil.Order(scheme, installable, installed, dependencies)
+	if err != nil {
 		return err
 	}
+	if len(ordered) == 0 {
+		w.log.Info("no packages to install")
+		return nil
+	}
+	installPackage, err := w.packageInstaller(machineType.Spec.Manufacturer)
+	if err != nil {
+		return err
+	}
+	w.setPackageState(ctx, target, lifecyclev1alpha1.PackageInstallStatePending, nil, ordered...)
+	var applied []lifecyclev1alpha1.PackageVersion
+	for _, pv := range ordered {
+		w.log.Info("installing package", "package", pv.Name, "version", pv.Version)
+		w.setPackageState(ctx, target, lifecyclev1alpha1.PackageInstallStateInstalling, nil, pv)
+		if err = installPackage(ctx, target, pv); err != nil {
+			w.setPackageState(ctx, target, lifecyclev1alpha1.PackageInstallStateFailed, err, pv)
+			return &installError{applied: applied, failed: pv, err: err}
+		}
+		// package taking effect after reboot succeeds once a scan finds it
+		if packageState(target.GetStatus(), pv.Name) != lifecyclev1alpha1.PackageInstallStateAwaitingReboot {
+			w.setPackageState(ctx, target, lifecyclev1alpha1.PackageInstallStateSucceeded, nil, pv)
+		}
+		setInstalledVersion(target.GetStatus(), pv)
+		applied = append(applied, pv)
+	}
+	w.log.Info("packages applied", "packages", packageList(applied))
+	return nil
+}
 
-	switch machineType.Spec.Manufacturer {
+// installablePackages filters pending packages, which might be installed.
+// Downgrades are skipped unless the downgrade policy allows them, packages of
+// the machine group are skipped unless the machine is admitted to the group
+// rollout, since both might have changed after installation was requested.
+// Nothing is installed if the machine group requires approval, unless the
+// approved revision matches packages pending installation, since packages
+// might have changed or the approval might have been rejected meanwhile.
+func (w *MachineLifecycleWorker) installablePackages(
+	target *machinev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+	pending []*machinev1alpha1.DesiredPackageVersion,
+) ([]lifecyclev1alpha1.PackageVersion, error) {
+	machine := &lifecyclev1alpha1.Machine{ObjectMeta: *target.GetObjectMeta()}
+	group, err := planutil.MachineGroup(machine, machineType)
+	if err != nil {
+		return nil, err
+	}
+	allowDowngrade := planutil.DowngradeAllowed(machine, planutil.DowngradePolicy(machineType, group))
+	admitted := rolloututil.Admitted(machine, machineType, group)
+	scheme := versionutil.ForManufacturer(machineType.Spec.Manufacturer)
+	var allowed, result []lifecyclev1alpha1.PackageVersion
+	for _, pv := range pending {
+		installed := installedVersion(target.GetStatus(), pv.Name)
+		if !allowDowngrade && planutil.IsDowngrade(scheme, pv.Version, installed) {
+			w.log.Warn("package downgrade denied by policy",
+				"package", pv.Name, "version", pv.Version, "installedVersion", installed)
+			continue
+		}
+		allowed = append(allowed, lifecyclev1alpha1.PackageVersion{Name: pv.Name, Version: pv.Version})
+		if !admitted && pv.Source == machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE_GROUP {
+			w.log.Warn("machine is not admitted to machine group rollout",
+				"package", pv.Name, "version", pv.Version, "machineGroup", pv.MachineGroup)
+			continue
+		}
+		w.log.Info("package pending installation",
+			"package", pv.Name, "version", pv.Version, "source", pv.Source, "machineGroup", pv.MachineGroup)
+		result = append(result, lifecyclev1alpha1.PackageVersion{Name: pv.Name, Version: pv.Version})
+	}
+	if !approvalutil.Required(group) || len(allowed) == 0 {
+		return result, nil
+	}
+	// revision is computed the same way as by the controller, from packages
+	// which versions differ from installed ones and are not blocked
+	approval := target.GetStatus().GetApproval()
+	revision := approvalutil.Revision(allowed)
+	if approval.GetState() != string(lifecyclev1alpha1.ApprovalStateApproved) || approval.GetRevision() != revision {
+		w.log.Warn("installation is not approved",
+			"revision", revision, "approvalRevision", approval.GetRevision(), "approvalState", approval.GetState())
+		return nil, nil
+	}
+	return result, nil
+}
+
+// setPackageState reflects the state of installation of packages in
+// machine's status and reports it to lifecycle-service. An attempt is counted
+// whenever installation of the version starts. Failures to report the state
+// are logged only, so they don't interrupt installation.
+func (w *MachineLifecycleWorker) setPackageState(
+	ctx context.Context,
+	target *machinev1alpha1.Machine,
+	state lifecyclev1alpha1.PackageInstallState,
+	installErr error,
+	packages ...lifecyclev1alpha1.PackageVersion,
+) {
+	now := &metav1.Timestamp{Seconds: time.Now().Unix()}
+	updates := make([]*machinev1alpha1.PackageInstallStatus, len(packages))
+	for i, pv := range packages {
+		item := packageStatus(target.GetStatus(), pv)
+		if lifecyclev1alpha1.PackageInstallState(item.State) != state {
+			item.LastTransitionTime = now
+		}
+		if state.IsActive() && !lifecyclev1alpha1.PackageInstallState(item.State).IsActive() {
+			item.Attempts++
+			item.StartTime = now
+			item.CompletionTime = nil
+		}
+		switch state {
+		case lifecyclev1alpha1.PackageInstallStateSucceeded:
+			item.CompletionTime = now
+			item.LastError = ""
+		case lifecyclev1alpha1.PackageInstallStateFailed:
+			item.CompletionTime = now
+			item.LastError = installErr.Error()
+		}
+		item.State = string(state)
+		item.JobId = w.jobID
+		updates[i] = item
+	}
+	resp, err := w.UpdatePackageStatus(ctx, connect.NewRequest(&machinev1alpha1.UpdatePackageStatusRequest{
+		Name:      target.ObjectMeta.Name,
+		Namespace: target.ObjectMeta.Namespace,
+		Packages:  updates,
+	}))
+	switch {
+	case err != nil:
+		w.log.Error("error updating package status", "state", state, "error", err)
+	case resp.Msg.Result != commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS:
+		w.log.Error("error updating package status", "state", state, "result", resp.Msg.Result)
+	}
+}
+
+// completeRebootedPackages marks packages awaiting reboot as succeeded once
+// the scan finds their versions installed.
+func (w *MachineLifecycleWorker) completeRebootedPackages(ctx context.Context, target *machinev1alpha1.Machine) {
+	var rebooted []lifecyclev1alpha1.PackageVersion
+	for _, item := range target.GetStatus().GetPackageStatuses() {
+		if lifecyclev1alpha1.PackageInstallState(item.State) == lifecyclev1alpha1.PackageInstallStateAwaitingReboot &&
+			installedVersion(target.GetStatus(), item.Name) == item.Version {
+			rebooted = append(rebooted, lifecyclev1alpha1.PackageVersion{Name: item.Name, Version: item.Version})
+		}
+	}
+	if len(rebooted) > 0 {
+		w.setPackageState(ctx, target, lifecyclev1alpha1.PackageInstallStateSucceeded, nil, rebooted...)
+	}
+}
+
+type packageInstallFunc func(ctx context.Context, target *machinev1alpha1.Machine,
+	pv lifecyclev1alpha1.PackageVersion) error
+
+func (w *MachineLifecycleWorker) packageInstaller(manufacturer string) (packageInstallFunc, error) {
+	switch manufacturer {
 	case "Lenovo":
-		return w.LenovoScanMachine(ctx, target)
+		return w.LenovoInstallPackage, nil
 	}
+	return nil, fmt.Errorf("installation of packages is not supported for manufacturer %q", manufacturer)
+}
 
-	return nil
+// installError reports the package failed to install along with packages
+// applied before the failure.
+type installError struct {
+	applied []lifecyclev1alpha1.PackageVersion
+	failed  lifecyclev1alpha1.PackageVersion
+	err     error
 }
 
-func (w *MachineLifecycleWorker) install(ctx context.Context, target *machinev1alpha1.Machine) error {
-	return nil
+func (e *installError) Error() string {
+	applied := "none"
+	if len(e.applied) > 0 {
+		applied = packageList(e.applied)
+	}
+	return fmt.Sprintf("failed to install %s %s: %s; applied packages: %s",
+		e.failed.Name, e.failed.Version, e.err, applied)
+}
+
+func (e *installError) Unwrap() error {
+	return e.err
+}
+
+func packageList(packages []lifecyclev1alpha1.PackageVersion) string {
+	items := make([]string, len(packages))
+	for i, pv := range packages {
+		items[i] = pv.Name + " " + pv.Version
+	}
+	return strings.Join(items, ", ")
+}
+
+func setInstalledVersion(status *machinev1alpha1.MachineStatus, pv lifecyclev1alpha1.PackageVersion) {
+	for _, item := range status.GetInstalledPackages() {
+		if item.Name == pv.Name {
+			item.Version = pv.Version
+			return
+		}
+	}
+	status.InstalledPackages = append(status.InstalledPackages,
+		&commonv1alpha1.PackageVersion{Name: pv.Name, Version: pv.Version})
+}
+
+// setInstallFailedCondition reports the result of installation in machine's
+// status. Transition time is kept while the result stays the same.
+func setInstallFailedCondition(status *machinev1alpha1.MachineStatus, generation int64, err error) {
+	if status == nil {
+		return
+	}
+	condition := &commonv1alpha1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionInstallFailed,
+		Status:             string(metav1.ConditionFalse),
+		ObservedGeneration: generation,
+		LastTransitionTime: &metav1.Timestamp{Seconds: time.Now().Unix()},
+		Reason:             "InstallationSucceeded",
+	}
+	if err != nil {
+		condition.Status = string(metav1.ConditionTrue)
+		condition.Reason = "InstallationFailed"
+		condition.Message = err.Error()
+	}
+	idx := slices.IndexFunc(status.Conditions, func(c *commonv1alpha1.Condition) bool {
+		return c.Type == lifecyclev1alpha1.MachineConditionInstallFailed
+	})
+	switch {
+	case idx < 0:
+		status.Conditions = append(status.Conditions, condition)
+	case status.Conditions[idx].Status == condition.Status:
+		condition.LastTransitionTime = status.Conditions[idx].LastTransitionTime
+		status.Conditions[idx] = condition
+	default:
+		status.Conditions[idx] = condition
+	}
+}
+
+// packageStatus returns the install status of the package version from
+// machine's status. The entry is created, if the package is missing or the
+// version differs, so attempts are counted per version.
+func packageStatus(
+	status *machinev1alpha1.MachineStatus,
+	pv lifecyclev1alpha1.PackageVersion,
+) *machinev1alpha1.PackageInstallStatus {
+	idx := slices.IndexFunc(status.PackageStatuses, func(item *machinev1alpha1.PackageInstallStatus) bool {
+		return item.Name == pv.Name
+	})
+	switch {
+	case idx < 0:
+		item := &machinev1alpha1.PackageInstallStatus{Name: pv.Name, Version: pv.Version}
+		status.PackageStatuses = append(status.PackageStatuses, item)
+		return item
+	case status.PackageStatuses[idx].Version != pv.Version:
+		status.PackageStatuses[idx] = &machinev1alpha1.PackageInstallStatus{Name: pv.Name, Version: pv.Version}
+	}
+	return status.PackageStatuses[idx]
+}
+
+func packageState(status *machinev1alpha1.MachineStatus, name string) lifecyclev1alpha1.PackageInstallState {
+	for _, item := range status.GetPackageStatuses() {
+		if item.Name == name {
+			return lifecyclev1alpha1.PackageInstallState(item.State)
+		}
+	}
+	return ""
+}
+
+func installedVersion(status *machinev1alpha1.MachineStatus, name string) string {
+	for _, pv := range status.GetInstalledPackages() {
+		if pv.Name == name {
+			return pv.Version
+		}
+	}
+	return ""
+}
+
+func pendingPackages(status *machinev1alpha1.MachineStatus) []*machinev1alpha1.DesiredPackageVersion {
+	installed := make(map[string]string, len(status.GetInstalledPackages()))
+	for _, pv := range status.GetInstalledPackages() {
+		installed[pv.Name] = pv.Version
+	}
+	var result []*machinev1alpha1.DesiredPackageVersion
+	for _, pv := range status.GetDesiredPackages() {
+		if installed[pv.Name] != pv.Version {
+			result = append(result, pv)
+		}
+	}
+	return result
 }
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"log/slog"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/approvalutil"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Machine lifecycle worker", func() {
+	var (
+		worker      *MachineLifecycleWorker
+		machineType *lifecyclev1alpha1.MachineType
+		target      *machinev1alpha1.Machine
+	)
+
+	BeforeEach(func() {
+		worker = &MachineLifecycleWorker{log: slog.New(slog.NewTextHandler(GinkgoWriter, nil))}
+		machineType = &lifecyclev1alpha1.MachineType{
+			Spec: lifecyclev1alpha1.MachineTypeSpec{
+				MachineGroups: []lifecyclev1alpha1.MachineGroup{{
+					Name:            "production",
+					MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					Approval:        lifecyclev1alpha1.ApprovalModeRequired,
+				}},
+			},
+		}
+		target = &machinev1alpha1.Machine{
+			ObjectMeta: &metav1.ObjectMeta{Name: "machine", Labels: map[string]string{"env": "prod"}},
+			Status: &machinev1alpha1.MachineStatus{
+				InstalledPackages: []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+			},
+		}
+	})
+
+	Context("Install approval", func() {
+		pending := []*machinev1alpha1.DesiredPackageVersion{{
+			Name:         "bios",
+			Version:      "2.0.0",
+			Source:       machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE_GROUP,
+			MachineGroup: "production",
+		}}
+		approve := func(version string) {
+			target.Status.Approval = &machinev1alpha1.InstallApproval{
+				Revision: approvalutil.Revision([]lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: version}}),
+				State:    string(lifecyclev1alpha1.ApprovalStateApproved),
+			}
+		}
+
+		It("Should install packages of approved revision", func() {
+			approve("2.0.0")
+			installable, err := worker.installablePackages(target, machineType, pending)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installable).To(Equal([]lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}))
+		})
+
+		It("Should skip packages if approved revision differs", func() {
+			approve("1.5.0")
+			installable, err := worker.installablePackages(target, machineType, pending)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installable).To(BeEmpty())
+		})
+
+		It("Should skip packages if approval is rejected", func() {
+			approve("2.0.0")
+			target.Status.Approval.State = string(lifecyclev1alpha1.ApprovalStateRejected)
+			installable, err := worker.installablePackages(target, machineType, pending)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installable).To(BeEmpty())
+		})
+
+		It("Should install packages without approval if it is not required", func() {
+			machineType.Spec.MachineGroups[0].Approval = lifecyclev1alpha1.ApprovalModeNone
+			installable, err := worker.installablePackages(target, machineType, pending)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(installable).To(HaveLen(1))
+		})
+	})
+})
@@ -6,11 +6,13 @@ package job
 import (
 	"log/slog"
 
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Options struct {
 	KubeClient client.Client
+	Recorder   record.EventRecorder
 	Log        *slog.Logger
 	JobID      string
 }
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	adminv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/admin/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/admin/v1alpha1/adminv1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// DefaultStateConfigMap is the name of the ConfigMap persisting the
+	// scheduling status by default.
+	DefaultStateConfigMap = "lifecycle-scheduling-state"
+
+	keyPaused    = "paused"
+	keyUser      = "user"
+	keyReason    = "reason"
+	keyPauseTime = "pauseTime"
+)
+
+// schedulingPaused reports whether scheduling of jobs is paused.
+var schedulingPaused = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "lifecycle_service_scheduling_paused",
+	Help: "Whether scheduling of lifecycle jobs is paused (1) or not.",
+})
+
+func init() {
+	prometheus.MustRegister(schedulingPaused)
+}
+
+// Scheduler is the scheduler of jobs, which might be paused.
+type Scheduler interface {
+	Pause()
+	Resume()
+	Paused() bool
+}
+
+type AdminService struct {
+	adminv1alpha1connect.UnimplementedAdminServiceHandler
+	schedulers []Scheduler
+
+	mu     sync.Mutex
+	status *adminv1alpha1.SchedulingStatus
+
+	configMaps typedcorev1.ConfigMapInterface
+	stateName  string
+}
+
+type Option func(service *AdminService)
+
+func NewService(opts ...Option) *AdminService {
+	svc := &AdminService{status: &adminv1alpha1.SchedulingStatus{}}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// WithSchedulers sets schedulers paused and resumed by the service.
+func WithSchedulers(schedulers ...Scheduler) Option {
+	return func(svc *AdminService) {
+		svc.schedulers = append(svc.schedulers, schedulers...)
+	}
+}
+
+// WithStateConfigMap persists the scheduling status in the named ConfigMap, so
+// the service restarted while scheduling is paused stays paused. The status
+// is kept in memory only, if the ConfigMap is not set.
+func WithStateConfigMap(configMaps typedcorev1.ConfigMapInterface, name string) Option {
+	return func(svc *AdminService) {
+		svc.configMaps = configMaps
+		svc.stateName = name
+	}
+}
+
+// Restore pauses schedulers if the persisted scheduling status is paused. It
+// must be called before schedulers start, so the restarted service starts no
+// jobs while scheduling is paused. Missing ConfigMap means not paused.
+func (s *AdminService) Restore(ctx context.Context) error {
+	if s.configMaps == nil {
+		return nil
+	}
+	configMap, err := s.configMaps.Get(ctx, s.stateName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get scheduling state: %w", err)
+	}
+	status := statusFromData(configMap.Data)
+	if !status.Paused {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+	for _, scheduler := range s.schedulers {
+		scheduler.Pause()
+	}
+	schedulingPaused.Set(1)
+	logr.FromContextAsSlogLogger(ctx).Info("scheduling paused on startup",
+		"user", status.User, "reason", status.Reason)
+	return nil
+}
+
+// PauseScheduling stops schedulers from starting new jobs. Requests are still
+// accepted and queued. Pausing already paused scheduling keeps the original
+// pause details. The status is persisted before schedulers are paused, so
+// the pause is not lost on restart. It is persisted even if the replica is
+// paused already, since another replica might have resumed scheduling.
+func (s *AdminService) PauseScheduling(
+	ctx context.Context,
+	c *connect.Request[adminv1alpha1.PauseSchedulingRequest],
+) (*connect.Response[adminv1alpha1.PauseSchedulingResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, _ := interceptor.UserFromContext(ctx)
+	status := s.status
+	if !status.Paused {
+		status = &adminv1alpha1.SchedulingStatus{
+			Paused:    true,
+			User:      user.Username,
+			Reason:    c.Msg.Reason,
+			PauseTime: &metav1.Timestamp{Seconds: time.Now().Unix()},
+		}
+	}
+	if err := s.persist(ctx, status); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !s.status.Paused {
+		s.status = status
+		for _, scheduler := range s.schedulers {
+			scheduler.Pause()
+		}
+		schedulingPaused.Set(1)
+		log.Info("scheduling paused", "user", user.Username, "reason", c.Msg.Reason)
+	}
+	return connect.NewResponse(&adminv1alpha1.PauseSchedulingResponse{Status: s.currentStatus()}), nil
+}
+
+// ResumeScheduling lets schedulers start jobs for queued requests. The status
+// is persisted even if the replica is not paused, since another replica might
+// have paused scheduling.
+func (s *AdminService) ResumeScheduling(
+	ctx context.Context,
+	c *connect.Request[adminv1alpha1.ResumeSchedulingRequest],
+) (*connect.Response[adminv1alpha1.ResumeSchedulingResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.persist(ctx, &adminv1alpha1.SchedulingStatus{}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if s.status.Paused {
+		user, _ := interceptor.UserFromContext(ctx)
+		s.status = &adminv1alpha1.SchedulingStatus{}
+		for _, scheduler := range s.schedulers {
+			scheduler.Resume()
+		}
+		schedulingPaused.Set(0)
+		log.Info("scheduling resumed", "user", user.Username)
+	}
+	return connect.NewResponse(&adminv1alpha1.ResumeSchedulingResponse{Status: s.currentStatus()}), nil
+}
+
+func (s *AdminService) GetSchedulingStatus(
+	ctx context.Context,
+	c *connect.Request[adminv1alpha1.GetSchedulingStatusRequest],
+) (*connect.Response[adminv1alpha1.GetSchedulingStatusResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return connect.NewResponse(&adminv1alpha1.GetSchedulingStatusResponse{Status: s.currentStatus()}), nil
+}
+
+// currentStatus returns the copy of the status, which must not be modified
+// after the lock is released.
+func (s *AdminService) currentStatus() *adminv1alpha1.SchedulingStatus {
+	return &adminv1alpha1.SchedulingStatus{
+		Paused:    s.status.Paused,
+		User:      s.status.User,
+		Reason:    s.status.Reason,
+		PauseTime: s.status.PauseTime,
+	}
+}
+
+// persist writes the scheduling status to the ConfigMap, if it is set.
+func (s *AdminService) persist(ctx context.Context, status *adminv1alpha1.SchedulingStatus) error {
+	if s.configMaps == nil {
+		return nil
+	}
+	data := statusToData(status)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := s.configMaps.Get(ctx, s.stateName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err = s.configMaps.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: s.stateName},
+				Data:       data,
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		configMap.Data = data
+		_, err = s.configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to persist scheduling state: %w", err)
+	}
+	return nil
+}
+
+func statusToData(status *adminv1alpha1.SchedulingStatus) map[string]string {
+	data := map[string]string{keyPaused: strconv.FormatBool(status.Paused)}
+	if !status.Paused {
+		return data
+	}
+	data[keyUser] = status.User
+	data[keyReason] = status.Reason
+	if status.PauseTime != nil {
+		data[keyPauseTime] = time.Unix(status.PauseTime.Seconds, 0).UTC().Format(time.RFC3339)
+	}
+	return data
+}
+
+func statusFromData(data map[string]string) *adminv1alpha1.SchedulingStatus {
+	paused, _ := strconv.ParseBool(data[keyPaused])
+	if !paused {
+		return &adminv1alpha1.SchedulingStatus{}
+	}
+	status := &adminv1alpha1.SchedulingStatus{
+		Paused: true,
+		User:   data[keyUser],
+		Reason: data[keyReason],
+	}
+	if pauseTime, err := time.Parse(time.RFC3339, data[keyPauseTime]); err == nil {
+		status.PauseTime = &metav1.Timestamp{Seconds: pauseTime.Unix()}
+	}
+	return status
+}
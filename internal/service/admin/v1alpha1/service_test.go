@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	adminv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/admin/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type fakeScheduler struct {
+	paused bool
+}
+
+func (f *fakeScheduler) Pause()       { f.paused = true }
+func (f *fakeScheduler) Resume()      { f.paused = false }
+func (f *fakeScheduler) Paused() bool { return f.paused }
+
+var _ = Describe("Admin service", func() {
+	ctx := logr.NewContextWithSlogLogger(context.Background(), slog.New(slog.NewTextHandler(GinkgoWriter, nil)))
+
+	Context("When scheduling is paused and resumed", func() {
+		It("Should pause all schedulers and report who paused them", func() {
+			machineScheduler, machineTypeScheduler := &fakeScheduler{}, &fakeScheduler{}
+			svc := NewService(WithSchedulers(machineScheduler, machineTypeScheduler))
+			userCtx := interceptor.ContextWithUser(ctx, authenticationv1.UserInfo{Username: "alice"})
+
+			pauseResp, err := svc.PauseScheduling(userCtx, connect.NewRequest(&adminv1alpha1.PauseSchedulingRequest{
+				Reason: "storage maintenance",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineScheduler.Paused()).To(BeTrue())
+			Expect(machineTypeScheduler.Paused()).To(BeTrue())
+			Expect(pauseResp.Msg.Status.Paused).To(BeTrue())
+			Expect(pauseResp.Msg.Status.User).To(Equal("alice"))
+			Expect(pauseResp.Msg.Status.Reason).To(Equal("storage maintenance"))
+			Expect(pauseResp.Msg.Status.PauseTime).NotTo(BeNil())
+
+			// repeated pause keeps the original details
+			_, err = svc.PauseScheduling(ctx, connect.NewRequest(&adminv1alpha1.PauseSchedulingRequest{
+				Reason: "another reason",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			statusResp, err := svc.GetSchedulingStatus(ctx,
+				connect.NewRequest(&adminv1alpha1.GetSchedulingStatusRequest{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statusResp.Msg.Status.User).To(Equal("alice"))
+			Expect(statusResp.Msg.Status.Reason).To(Equal("storage maintenance"))
+
+			resumeResp, err := svc.ResumeScheduling(userCtx,
+				connect.NewRequest(&adminv1alpha1.ResumeSchedulingRequest{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineScheduler.Paused()).To(BeFalse())
+			Expect(machineTypeScheduler.Paused()).To(BeFalse())
+			Expect(resumeResp.Msg.Status.Paused).To(BeFalse())
+			Expect(resumeResp.Msg.Status.User).To(BeEmpty())
+		})
+	})
+
+	Context("When scheduling state is persisted", func() {
+		var clientset *fake.Clientset
+
+		BeforeEach(func() {
+			clientset = fake.NewSimpleClientset()
+		})
+
+		newService := func(schedulers ...Scheduler) *AdminService {
+			return NewService(WithSchedulers(schedulers...),
+				WithStateConfigMap(clientset.CoreV1().ConfigMaps("metal"), DefaultStateConfigMap))
+		}
+
+		It("Should restore paused scheduling on restart", func() {
+			userCtx := interceptor.ContextWithUser(ctx, authenticationv1.UserInfo{Username: "alice"})
+			_, err := newService(&fakeScheduler{}).PauseScheduling(userCtx,
+				connect.NewRequest(&adminv1alpha1.PauseSchedulingRequest{Reason: "storage maintenance"}))
+			Expect(err).NotTo(HaveOccurred())
+
+			machineScheduler := &fakeScheduler{}
+			svc := newService(machineScheduler)
+			Expect(svc.Restore(ctx)).To(Succeed())
+			Expect(machineScheduler.Paused()).To(BeTrue())
+			statusResp, err := svc.GetSchedulingStatus(ctx,
+				connect.NewRequest(&adminv1alpha1.GetSchedulingStatusRequest{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statusResp.Msg.Status.Paused).To(BeTrue())
+			Expect(statusResp.Msg.Status.User).To(Equal("alice"))
+			Expect(statusResp.Msg.Status.Reason).To(Equal("storage maintenance"))
+			Expect(statusResp.Msg.Status.PauseTime).NotTo(BeNil())
+
+			_, err = svc.ResumeScheduling(ctx, connect.NewRequest(&adminv1alpha1.ResumeSchedulingRequest{}))
+			Expect(err).NotTo(HaveOccurred())
+			machineScheduler = &fakeScheduler{}
+			Expect(newService(machineScheduler).Restore(ctx)).To(Succeed())
+			Expect(machineScheduler.Paused()).To(BeFalse())
+		})
+
+		It("Should persist resume of scheduling paused by another replica", func() {
+			_, err := newService().PauseScheduling(ctx,
+				connect.NewRequest(&adminv1alpha1.PauseSchedulingRequest{}))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = newService().ResumeScheduling(ctx, connect.NewRequest(&adminv1alpha1.ResumeSchedulingRequest{}))
+			Expect(err).NotTo(HaveOccurred())
+			configMap, err := clientset.CoreV1().ConfigMaps("metal").
+				Get(ctx, DefaultStateConfigMap, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configMap.Data).To(Equal(map[string]string{"paused": "false"}))
+		})
+
+		It("Should not pause scheduling if the state is not persisted", func() {
+			clientset.PrependReactor("create", "configmaps",
+				func(k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("api server unavailable")
+				})
+			machineScheduler := &fakeScheduler{}
+			_, err := newService(machineScheduler).PauseScheduling(ctx,
+				connect.NewRequest(&adminv1alpha1.PauseSchedulingRequest{}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInternal))
+			Expect(machineScheduler.Paused()).To(BeFalse())
+		})
+	})
+})
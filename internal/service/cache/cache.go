@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/informers/externalversions"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle"
+	lifecyclelisters "github.com/ironcore-dev/lifecycle-manager/clientgo/listers/lifecycle/v1alpha1"
+)
+
+type Option func(*Cache)
+
+// Cache keeps Machine and MachineType objects of the watched namespaces in
+// shared informers, so services are able to serve reads without requests to
+// the API server.
+type Cache struct {
+	client     lifecycle.Interface
+	resync     time.Duration
+	namespaces []string
+	factories  map[string]externalversions.SharedInformerFactory
+}
+
+// New creates informers for every namespace from the list. Empty namespace
+// name stands for all namespaces.
+func New(c lifecycle.Interface, namespaces []string, opts ...Option) *Cache {
+	cc := &Cache{
+		client:     c,
+		namespaces: namespaces,
+		factories:  make(map[string]externalversions.SharedInformerFactory, len(namespaces)),
+	}
+	for _, opt := range opts {
+		opt(cc)
+	}
+	for _, namespace := range cc.namespaces {
+		factory := externalversions.NewSharedInformerFactoryWithOptions(
+			cc.client, cc.resync, externalversions.WithNamespace(namespace))
+		// informers have to be requested before factory is started
+		_ = factory.Lifecycle().V1alpha1().Machines().Informer()
+		_ = factory.Lifecycle().V1alpha1().MachineTypes().Informer()
+		cc.factories[namespace] = factory
+	}
+	return cc
+}
+
+// WithResyncPeriod defines how often informers re-deliver cached objects.
+func WithResyncPeriod(resync time.Duration) Option {
+	return func(c *Cache) {
+		c.resync = resync
+	}
+}
+
+// Start runs informers for all watched namespaces. It does not block.
+func (c *Cache) Start(ctx context.Context) {
+	for _, factory := range c.factories {
+		factory.Start(ctx.Done())
+	}
+}
+
+// WaitForCacheSync blocks until all informers are synced or ctx is done.
+func (c *Cache) WaitForCacheSync(ctx context.Context) error {
+	for namespace, factory := range c.factories {
+		for informer, synced := range factory.WaitForCacheSync(ctx.Done()) {
+			if !synced {
+				return fmt.Errorf("failed to sync %s cache in namespace %q", informer.String(), namespace)
+			}
+		}
+	}
+	return nil
+}
+
+// Machines returns lister for Machine objects in the namespace. The second
+// value is false if the namespace is not watched.
+func (c *Cache) Machines(namespace string) (lifecyclelisters.MachineNamespaceLister, bool) {
+	factory, ok := c.factory(namespace)
+	if !ok {
+		return nil, false
+	}
+	return factory.Lifecycle().V1alpha1().Machines().Lister().Machines(namespace), true
+}
+
+// MachineTypes returns lister for MachineType objects in the namespace. The
+// second value is false if the namespace is not watched.
+func (c *Cache) MachineTypes(namespace string) (lifecyclelisters.MachineTypeNamespaceLister, bool) {
+	factory, ok := c.factory(namespace)
+	if !ok {
+		return nil, false
+	}
+	return factory.Lifecycle().V1alpha1().MachineTypes().Lister().MachineTypes(namespace), true
+}
+
+func (c *Cache) factory(namespace string) (externalversions.SharedInformerFactory, bool) {
+	if c == nil {
+		return nil, false
+	}
+	if factory, ok := c.factories[namespace]; ok {
+		return factory, true
+	}
+	factory, ok := c.factories[""]
+	return factory, ok
+}
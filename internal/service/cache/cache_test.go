@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/informers/fake"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/testutil/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("Cache", func() {
+	var fixture *fake.Fixture
+
+	BeforeEach(func() {
+		fixture = fake.NewFixture(
+			mock.NewUnstructuredBuilder().WithName("machine-a").WithNamespace("metal-a").
+				MachineFromUnstructured().Complete(),
+			mock.NewUnstructuredBuilder().WithName("machine-b").WithNamespace("metal-b").
+				MachineFromUnstructured().Complete(),
+			mock.NewUnstructuredBuilder().WithName("machinetype-a").WithNamespace("metal-a").
+				MachineTypeFromUnstructured().Complete(),
+		)
+	})
+
+	start := func(namespaces ...string) *Cache {
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		c := New(fixture.Clientset, namespaces)
+		c.Start(ctx)
+		Expect(c.WaitForCacheSync(ctx)).To(Succeed())
+		// machine and machine type informers per namespace
+		Expect(fixture.WaitForWatches(ctx, 2*len(namespaces))).To(Succeed())
+		return c
+	}
+
+	It("Should serve objects only from watched namespaces", func() {
+		c := start("metal-a")
+
+		machines, ok := c.Machines("metal-a")
+		Expect(ok).To(BeTrue())
+		machine, err := machines.Get("machine-a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machine.Namespace).To(Equal("metal-a"))
+		_, err = machines.Get("machine-b")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		machineTypes, ok := c.MachineTypes("metal-a")
+		Expect(ok).To(BeTrue())
+		items, err := machineTypes.List(labels.Everything())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(HaveLen(1))
+
+		_, ok = c.Machines("metal-b")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should serve any namespace when all namespaces are watched", func() {
+		c := start(metav1.NamespaceAll)
+
+		machines, ok := c.Machines("metal-b")
+		Expect(ok).To(BeTrue())
+		items, err := machines.List(labels.Everything())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(HaveLen(1))
+		Expect(items[0].Name).To(Equal("machine-b"))
+	})
+
+	It("Should observe updates", func() {
+		c := start("metal-a")
+		machine := mock.NewUnstructuredBuilder().WithName("machine-c").WithNamespace("metal-a").
+			MachineFromUnstructured().Complete()
+		_, err := fixture.Clientset.LifecycleV1alpha1().Machines("metal-a").
+			Create(context.Background(), machine, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		machines, _ := c.Machines("metal-a")
+		Eventually(func() (*lifecyclev1alpha1.Machine, error) {
+			return machines.Get("machine-c")
+		}).ShouldNot(BeNil())
+	})
+
+	It("Should not serve anything when cache is not set", func() {
+		var c *Cache
+		_, ok := c.Machines("metal-a")
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jellydator/ttlcache/v3"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	lifecycleGroup = "lifecycle.ironcore.dev"
+
+	defaultDecisionTTL      = time.Minute
+	defaultDecisionCapacity = 4096
+)
+
+var (
+	errNoToken          = errors.New("bearer token is missing")
+	errNotAuthenticated = errors.New("bearer token is not valid")
+	errUnknownProcedure = errors.New("procedure is not mapped to any resource")
+	errNotAuthorized    = errors.New("request is not authorized yet")
+)
+
+// ResourceAttributes defines the kubernetes verb and resource the caller
+// must be allowed to use in order to call a procedure.
+type ResourceAttributes struct {
+	Verb        string
+	Resource    string
+	Subresource string
+}
+
+type namespacedRequest interface {
+	GetNamespace() string
+}
+
+type namedRequest interface {
+	GetName() string
+}
+
+type userKey struct{}
+
+// ContextWithUser returns a copy of ctx carrying the authenticated caller.
+func ContextWithUser(ctx context.Context, user authenticationv1.UserInfo) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the authenticated caller of the procedure, if the
+// request is authorized by AuthorizationInterceptor.
+func UserFromContext(ctx context.Context) (authenticationv1.UserInfo, bool) {
+	user, ok := ctx.Value(userKey{}).(authenticationv1.UserInfo)
+	return user, ok
+}
+
+type AuthorizationOption func(*AuthorizationInterceptor)
+
+// AuthorizationInterceptor authenticates the caller's bearer token with
+// TokenReview and checks whether the caller is allowed to call the procedure
+// with SubjectAccessReview in the namespace of the request.
+type AuthorizationInterceptor struct {
+	logger      *slog.Logger
+	client      kubernetes.Interface
+	namespace   string
+	permissions map[string]ResourceAttributes
+	ttl         time.Duration
+	users       *ttlcache.Cache[string, authenticationv1.UserInfo]
+	decisions   *ttlcache.Cache[string, bool]
+}
+
+func NewAuthorizationInterceptor(
+	log *slog.Logger,
+	c kubernetes.Interface,
+	namespace string,
+	permissions map[string]ResourceAttributes,
+	opts ...AuthorizationOption,
+) *AuthorizationInterceptor {
+	a := &AuthorizationInterceptor{
+		logger:      log,
+		client:      c,
+		namespace:   namespace,
+		permissions: permissions,
+		ttl:         defaultDecisionTTL,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	a.users = ttlcache.New[string, authenticationv1.UserInfo](
+		ttlcache.WithTTL[string, authenticationv1.UserInfo](a.ttl),
+		ttlcache.WithCapacity[string, authenticationv1.UserInfo](defaultDecisionCapacity))
+	a.decisions = ttlcache.New[string, bool](
+		ttlcache.WithTTL[string, bool](a.ttl),
+		ttlcache.WithCapacity[string, bool](defaultDecisionCapacity))
+	return a
+}
+
+// WithDecisionTTL defines how long authentication and authorization
+// decisions are cached.
+func WithDecisionTTL(ttl time.Duration) AuthorizationOption {
+	return func(a *AuthorizationInterceptor) {
+		a.ttl = ttl
+	}
+}
+
+func (a *AuthorizationInterceptor) WrapUnary(unaryFunc connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return unaryFunc(ctx, req)
+		}
+		namespace, name := a.target(req.Any())
+		ctx, err := a.authorize(ctx, req.Spec().Procedure, req.Header(), namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		return unaryFunc(ctx, req)
+	}
+}
+
+func (a *AuthorizationInterceptor) WrapStreamingClient(
+	clientFunc connect.StreamingClientFunc,
+) connect.StreamingClientFunc {
+	return clientFunc
+}
+
+func (a *AuthorizationInterceptor) WrapStreamingHandler(
+	handlerFunc connect.StreamingHandlerFunc,
+) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		user, attributes, err := a.authenticate(ctx, procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		// namespace of the request is known only after the first message is
+		// received, so access is checked by the connection
+		return handlerFunc(ContextWithUser(ctx, user), &authorizingHandlerConn{
+			StreamingHandlerConn: conn,
+			check: func(msg any) error {
+				namespace, name := a.target(msg)
+				return a.check(ctx, procedure, user, attributes, namespace, name)
+			},
+		})
+	}
+}
+
+// authorizingHandlerConn checks access of the caller with the first received
+// message. Nothing is sent to the caller before access is allowed.
+type authorizingHandlerConn struct {
+	connect.StreamingHandlerConn
+	check      func(msg any) error
+	authorized bool
+}
+
+func (c *authorizingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if c.authorized {
+		return nil
+	}
+	if err := c.check(msg); err != nil {
+		return err
+	}
+	c.authorized = true
+	return nil
+}
+
+func (c *authorizingHandlerConn) Send(msg any) error {
+	if !c.authorized {
+		return connect.NewError(connect.CodePermissionDenied, errNotAuthorized)
+	}
+	return c.StreamingHandlerConn.Send(msg)
+}
+
+// Start runs the expiration of cached decisions. It blocks until ctx is done.
+func (a *AuthorizationInterceptor) Start(ctx context.Context) {
+	go a.users.Start()
+	go a.decisions.Start()
+	<-ctx.Done()
+	a.users.Stop()
+	a.decisions.Stop()
+}
+
+func (a *AuthorizationInterceptor) authorize(
+	ctx context.Context,
+	procedure string,
+	header http.Header,
+	namespace, name string,
+) (context.Context, error) {
+	user, attributes, err := a.authenticate(ctx, procedure, header)
+	if err != nil {
+		return ctx, err
+	}
+	if err = a.check(ctx, procedure, user, attributes, namespace, name); err != nil {
+		return ctx, err
+	}
+	return ContextWithUser(ctx, user), nil
+}
+
+// target returns namespace and name of the resource the request message
+// refers to. Service's own namespace is used if message has no namespace.
+func (a *AuthorizationInterceptor) target(msg any) (namespace, name string) {
+	namespace = a.namespace
+	if req, ok := msg.(namespacedRequest); ok && req.GetNamespace() != "" {
+		namespace = req.GetNamespace()
+	}
+	if req, ok := msg.(namedRequest); ok {
+		name = req.GetName()
+	}
+	return namespace, name
+}
+
+// authenticate returns the caller of the procedure and the permission the
+// caller must have to call it.
+func (a *AuthorizationInterceptor) authenticate(
+	ctx context.Context,
+	procedure string,
+	header http.Header,
+) (authenticationv1.UserInfo, ResourceAttributes, error) {
+	log := a.logger.With("endpoint", procedure)
+	attributes, ok := a.permissions[ProcedureName(procedure)]
+	if !ok {
+		log.Warn("request denied", "error", errUnknownProcedure.Error())
+		return authenticationv1.UserInfo{}, attributes,
+			connect.NewError(connect.CodePermissionDenied, errUnknownProcedure)
+	}
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return authenticationv1.UserInfo{}, attributes, connect.NewError(connect.CodeUnauthenticated, errNoToken)
+	}
+	user, err := a.reviewToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, errNotAuthenticated) {
+			return user, attributes, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		log.Error("failed to review token", "error", err.Error())
+		return user, attributes, connect.NewError(connect.CodeInternal, err)
+	}
+	return user, attributes, nil
+}
+
+// check returns an error if the caller is not allowed to use the resource in
+// the namespace.
+func (a *AuthorizationInterceptor) check(
+	ctx context.Context,
+	procedure string,
+	user authenticationv1.UserInfo,
+	attributes ResourceAttributes,
+	namespace, name string,
+) error {
+	allowed, err := a.review(ctx, user, attributes, namespace, name)
+	if err != nil {
+		a.logger.With("endpoint", procedure).Error("failed to review access", "error", err.Error())
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !allowed {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf(
+			"user %q cannot %s resource %q in namespace %q",
+			user.Username, attributes.Verb, attributes.resourceName(), namespace))
+	}
+	return nil
+}
+
+func (a *AuthorizationInterceptor) reviewToken(
+	ctx context.Context,
+	token string,
+) (authenticationv1.UserInfo, error) {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	if item := a.users.Get(key); item != nil {
+		return item.Value(), nil
+	}
+	review, err := a.client.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return authenticationv1.UserInfo{}, err
+	}
+	if !review.Status.Authenticated {
+		return authenticationv1.UserInfo{}, errNotAuthenticated
+	}
+	a.users.Set(key, review.Status.User, ttlcache.DefaultTTL)
+	return review.Status.User, nil
+}
+
+func (a *AuthorizationInterceptor) review(
+	ctx context.Context,
+	user authenticationv1.UserInfo,
+	attributes ResourceAttributes,
+	namespace, name string,
+) (bool, error) {
+	key := strings.Join([]string{
+		user.Username, strings.Join(user.Groups, ","),
+		attributes.Verb, attributes.resourceName(), namespace, name}, "|")
+	if item := a.decisions.Get(key); item != nil {
+		return item.Value(), nil
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	review, err := a.client.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        attributes.Verb,
+				Group:       lifecycleGroup,
+				Resource:    attributes.Resource,
+				Subresource: attributes.Subresource,
+				Name:        name,
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			Extra:  extra,
+			UID:    user.UID,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	a.decisions.Set(key, review.Status.Allowed, ttlcache.DefaultTTL)
+	return review.Status.Allowed, nil
+}
+
+func (r ResourceAttributes) resourceName() string {
+	if r.Subresource == "" {
+		return r.Resource
+	}
+	return r.Resource + "/" + r.Subresource
+}
+
+// ProcedureName converts connect procedure path, e.g.
+// "/machine.v1alpha1.MachineService/Install", to fully qualified
+// method name, e.g. "machine.v1alpha1.MachineService.Install".
+func ProcedureName(procedure string) string {
+	return strings.Replace(strings.TrimPrefix(procedure, "/"), "/", ".", 1)
+}
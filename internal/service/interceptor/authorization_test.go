@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"connectrpc.com/connect"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	installProcedure = "/machine.v1alpha1.MachineService/Install"
+	validToken       = "valid"
+)
+
+var _ = Describe("Authorization Interceptor", func() {
+	var (
+		clientset *fake.Clientset
+		reviews   []authorizationv1.SubjectAccessReviewSpec
+		auth      *AuthorizationInterceptor
+	)
+
+	BeforeEach(func() {
+		reviews = nil
+		clientset = fake.NewSimpleClientset()
+		clientset.PrependReactor("create", "tokenreviews",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+				if review.Spec.Token == validToken {
+					review.Status.Authenticated = true
+					review.Status.User = authenticationv1.UserInfo{Username: "alice", Groups: []string{"team-a"}}
+				}
+				return true, review, nil
+			})
+		clientset.PrependReactor("create", "subjectaccessreviews",
+			func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				reviews = append(reviews, review.Spec)
+				review.Status.Allowed = review.Spec.ResourceAttributes.Namespace == "team-a"
+				return true, review, nil
+			})
+		auth = NewAuthorizationInterceptor(
+			slog.New(slog.NewTextHandler(os.Stdout, nil)), clientset, "default",
+			map[string]ResourceAttributes{
+				"machine.v1alpha1.MachineService.Install": {
+					Verb: "create", Resource: "machines", Subresource: "install"},
+			})
+	})
+
+	header := func(token string) http.Header {
+		h := http.Header{}
+		if token != "" {
+			h.Set("Authorization", "Bearer "+token)
+		}
+		return h
+	}
+
+	It("Should reject request without token", func() {
+		_, err := auth.authorize(context.Background(), installProcedure, header(""), "team-a", "m1")
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeUnauthenticated))
+	})
+
+	It("Should reject request with invalid token", func() {
+		_, err := auth.authorize(context.Background(), installProcedure, header("invalid"), "team-a", "m1")
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeUnauthenticated))
+	})
+
+	It("Should reject unknown procedure", func() {
+		_, err := auth.authorize(context.Background(), "/machine.v1alpha1.MachineService/Unknown",
+			header(validToken), "team-a", "m1")
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("Should check access in target namespace", func() {
+		ctx, err := auth.authorize(context.Background(), installProcedure, header(validToken), "team-a", "m1")
+		Expect(err).NotTo(HaveOccurred())
+		user, ok := UserFromContext(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(user.Username).To(Equal("alice"))
+		Expect(reviews).To(HaveLen(1))
+		Expect(reviews[0].User).To(Equal("alice"))
+		Expect(reviews[0].Groups).To(Equal([]string{"team-a"}))
+		Expect(*reviews[0].ResourceAttributes).To(Equal(authorizationv1.ResourceAttributes{
+			Namespace:   "team-a",
+			Verb:        "create",
+			Group:       "lifecycle.ironcore.dev",
+			Resource:    "machines",
+			Subresource: "install",
+			Name:        "m1",
+		}))
+
+		_, err = auth.authorize(context.Background(), installProcedure, header(validToken), "team-b", "m1")
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodePermissionDenied))
+	})
+
+	It("Should cache decisions", func() {
+		for range 3 {
+			_, err := auth.authorize(context.Background(), installProcedure, header(validToken), "team-a", "m1")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(reviews).To(HaveLen(1))
+		tokenReviews := 0
+		for _, action := range clientset.Actions() {
+			if action.GetResource().Resource == "tokenreviews" {
+				tokenReviews++
+			}
+		}
+		Expect(tokenReviews).To(Equal(1))
+	})
+
+	It("Should check access of stream in namespace of the first message", func() {
+		stream := func(namespace string) error {
+			conn := &fakeHandlerConn{
+				procedure: installProcedure,
+				header:    header(validToken),
+				requests:  []proto.Message{&machinev1alpha1.InstallRequest{Name: "m1", Namespace: namespace}},
+			}
+			handler := auth.WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+				user, ok := UserFromContext(ctx)
+				Expect(ok).To(BeTrue())
+				Expect(user.Username).To(Equal("alice"))
+				if err := conn.Receive(&machinev1alpha1.InstallRequest{}); err != nil {
+					return err
+				}
+				return conn.Send(&machinev1alpha1.InstallResponse{})
+			})
+			err := handler(context.Background(), conn)
+			if err != nil {
+				Expect(conn.sent).To(BeZero())
+			}
+			return err
+		}
+
+		Expect(stream("team-a")).To(Succeed())
+		Expect(reviews).To(HaveLen(1))
+		Expect(reviews[0].ResourceAttributes.Namespace).To(Equal("team-a"))
+		Expect(reviews[0].ResourceAttributes.Name).To(Equal("m1"))
+
+		Expect(connect.CodeOf(stream("team-b"))).To(Equal(connect.CodePermissionDenied))
+		Expect(reviews).To(HaveLen(2))
+		Expect(reviews[1].ResourceAttributes.Namespace).To(Equal("team-b"))
+	})
+
+	It("Should not send stream messages before access is checked", func() {
+		conn := &fakeHandlerConn{procedure: installProcedure, header: header(validToken)}
+		handler := auth.WrapStreamingHandler(func(_ context.Context, conn connect.StreamingHandlerConn) error {
+			return conn.Send(&machinev1alpha1.InstallResponse{})
+		})
+		Expect(connect.CodeOf(handler(context.Background(), conn))).To(Equal(connect.CodePermissionDenied))
+		Expect(conn.sent).To(BeZero())
+	})
+})
+
+var _ = Describe("Procedure name", func() {
+	It("Should convert procedure path to method name", func() {
+		Expect(ProcedureName(installProcedure)).To(Equal("machine.v1alpha1.MachineService.Install"))
+	})
+})
+
+type fakeHandlerConn struct {
+	connect.StreamingHandlerConn
+	procedure string
+	header    http.Header
+	requests  []proto.Message
+	sent      int
+}
+
+func (c *fakeHandlerConn) Spec() connect.Spec {
+	return connect.Spec{Procedure: c.procedure, StreamType: connect.StreamTypeBidi}
+}
+
+func (c *fakeHandlerConn) RequestHeader() http.Header {
+	return c.header
+}
+
+func (c *fakeHandlerConn) Receive(msg any) error {
+	if len(c.requests) == 0 {
+		return io.EOF
+	}
+	proto.Merge(msg.(proto.Message), c.requests[0])
+	c.requests = c.requests[1:]
+	return nil
+}
+
+func (c *fakeHandlerConn) Send(any) error {
+	c.sent++
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"k8s.io/client-go/rest"
+)
+
+// TokenInterceptor attaches the bearer token of the kubernetes client
+// configuration to outgoing requests, so lifecycle-service is able to
+// authorize the caller.
+type TokenInterceptor struct {
+	token     string
+	tokenFile string
+}
+
+func NewTokenInterceptor(cfg *rest.Config) *TokenInterceptor {
+	return &TokenInterceptor{
+		token:     cfg.BearerToken,
+		tokenFile: cfg.BearerTokenFile,
+	}
+}
+
+func (t *TokenInterceptor) WrapUnary(unaryFunc connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			if err := t.setHeader(req.Header().Set); err != nil {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+		}
+		return unaryFunc(ctx, req)
+	}
+}
+
+func (t *TokenInterceptor) WrapStreamingClient(clientFunc connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := clientFunc(ctx, spec)
+		if err := t.setHeader(conn.RequestHeader().Set); err != nil {
+			return &erroredClientConn{
+				StreamingClientConn: conn,
+				err:                 connect.NewError(connect.CodeUnauthenticated, err),
+			}
+		}
+		return conn
+	}
+}
+
+func (t *TokenInterceptor) WrapStreamingHandler(handlerFunc connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return handlerFunc
+}
+
+func (t *TokenInterceptor) setHeader(set func(key, value string)) error {
+	token := t.token
+	if t.tokenFile != "" {
+		// token file is re-read on every request as projected service
+		// account tokens are rotated by kubelet
+		data, err := os.ReadFile(t.tokenFile)
+		if err != nil {
+			return err
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	if token == "" {
+		return nil
+	}
+	set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// erroredClientConn fails the stream with the error occurred before the
+// request is sent, so the stream is never sent without the token.
+type erroredClientConn struct {
+	connect.StreamingClientConn
+	err error
+}
+
+func (c *erroredClientConn) Send(any) error {
+	return c.err
+}
+
+func (c *erroredClientConn) CloseRequest() error {
+	return c.err
+}
+
+func (c *erroredClientConn) Receive(any) error {
+	return c.err
+}
+
+// CloseResponse does not wait for the response of the request, which is never
+// sent.
+func (c *erroredClientConn) CloseResponse() error {
+	return nil
+}
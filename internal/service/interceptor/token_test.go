@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package interceptor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+	storagev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/storage/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/storage/v1alpha1/commonv1alpha1connect"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("Token Interceptor", func() {
+	It("Should fail stream if token file cannot be read", func() {
+		var requests atomic.Int32
+		_, handler := commonv1alpha1connect.NewFirmwareStorageServiceHandler(
+			commonv1alpha1connect.UnimplementedFirmwareStorageServiceHandler{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			handler.ServeHTTP(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "missing")
+		client := commonv1alpha1connect.NewFirmwareStorageServiceClient(server.Client(), server.URL,
+			connect.WithInterceptors(NewTokenInterceptor(&rest.Config{BearerTokenFile: tokenFile})))
+		stream := client.Upload(context.Background())
+		err := stream.Send(&storagev1alpha1.UploadRequest{Id: "id"})
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeUnauthenticated))
+		_, err = stream.CloseAndReceive()
+		Expect(connect.CodeOf(err)).To(Equal(connect.CodeUnauthenticated))
+		Expect(err).To(MatchError(ContainSubstring(tokenFile)))
+		Expect(requests.Load()).To(BeZero())
+	})
+})
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/scheduler"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/apiutil"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// GetMachineHistory returns recorded changes of Machine's firmware, the most
+// recent one is the last. Changes might be filtered by package and limited to
+// the most recent ones.
+func (s *MachineService) GetMachineHistory(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.GetMachineHistoryRequest],
+) (*connect.Response[machinev1alpha1.GetMachineHistoryResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
+	}
+
+	history, err := s.c.LifecycleV1alpha1().MachineHistories(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		// history is created on the first change of machine's firmware
+		if _, err = s.getMachine(ctx, namespace, req.Name); err != nil {
+			errCode := connect.CodeInternal
+			if apierrors.IsNotFound(err) {
+				errCode = connect.CodeNotFound
+			}
+			return nil, connect.NewError(errCode, err)
+		}
+		history = &lifecyclev1alpha1.MachineHistory{}
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	changes := history.Changes
+	if req.Package != "" {
+		changes = slices.DeleteFunc(slices.Clone(changes), func(change lifecyclev1alpha1.FirmwareChange) bool {
+			return change.Package != req.Package
+		})
+	}
+	if limit := int(req.Limit); limit > 0 && len(changes) > limit {
+		changes = changes[len(changes)-limit:]
+	}
+	return connect.NewResponse(&machinev1alpha1.GetMachineHistoryResponse{
+		Changes: apiutil.FirmwareChangesToGrpcAPI(changes),
+	}), nil
+}
+
+// recordHistory appends changes to the history of the machine, the history is
+// created with the first change. The oldest changes are dropped once the
+// limit is exceeded.
+func (s *MachineService) recordHistory(
+	ctx context.Context,
+	machine *lifecyclev1alpha1.Machine,
+	changes []lifecyclev1alpha1.FirmwareChange,
+) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	histories := s.c.LifecycleV1alpha1().MachineHistories(machine.Namespace)
+	retriable := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+	return retry.OnError(retry.DefaultRetry, retriable, func() error {
+		history, err := histories.Get(ctx, machine.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			history = &lifecyclev1alpha1.MachineHistory{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      machine.Name,
+					Namespace: machine.Namespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: lifecyclev1alpha1.SchemeGroupVersion.String(),
+						Kind:       "Machine",
+						Name:       machine.Name,
+						UID:        machine.UID,
+					}},
+				},
+			}
+			history.Changes, _ = appendChanges(nil, changes, s.historyLimit)
+			_, err = histories.Create(ctx, history, metav1.CreateOptions{
+				FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
+			})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		var changed bool
+		if history.Changes, changed = appendChanges(history.Changes, changes, s.historyLimit); !changed {
+			return nil
+		}
+		_, err = histories.Update(ctx, history, metav1.UpdateOptions{
+			FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
+		})
+		return err
+	})
+}
+
+// firmwareChanges returns changes between versions of packages installed
+// according to machine's status and reported by the job. Packages missing in
+// the report are not considered removed, since scans might be incomplete.
+func firmwareChanges(
+	installed []lifecyclev1alpha1.PackageVersion,
+	reported []*commonv1alpha1.PackageVersion,
+	task scheduler.Task[*lifecyclev1alpha1.Machine],
+	now time.Time,
+) []lifecyclev1alpha1.FirmwareChange {
+	source := lifecyclev1alpha1.FirmwareChangeSourceScan
+	if task.Type == scheduler.InstallJob {
+		source = lifecyclev1alpha1.FirmwareChangeSourceInstall
+	}
+	var result []lifecyclev1alpha1.FirmwareChange
+	for _, pv := range reported {
+		var oldVersion string
+		if idx := slices.IndexFunc(installed, func(item lifecyclev1alpha1.PackageVersion) bool {
+			return item.Name == pv.Name
+		}); idx >= 0 {
+			oldVersion = installed[idx].Version
+		}
+		if oldVersion == pv.Version {
+			continue
+		}
+		result = append(result, lifecyclev1alpha1.FirmwareChange{
+			Time:       metav1.NewTime(now),
+			Package:    pv.Name,
+			OldVersion: oldVersion,
+			NewVersion: pv.Version,
+			Source:     source,
+			JobID:      task.Key,
+			Initiator:  task.Initiator,
+		})
+	}
+	return result
+}
+
+// appendChanges returns the copy of history with changes appended, keeping at
+// most limit of the most recent ones. Changes to the version already recorded
+// as the latest one of the package are skipped, since reports of the job might
+// be retried. The second return value reports whether any change was appended.
+func appendChanges(
+	history, changes []lifecyclev1alpha1.FirmwareChange,
+	limit int,
+) ([]lifecyclev1alpha1.FirmwareChange, bool) {
+	result := slices.Clone(history)
+	var changed bool
+	for _, change := range changes {
+		if latest := latestChange(result, change.Package); latest != nil && latest.NewVersion == change.NewVersion {
+			continue
+		}
+		result = append(result, change)
+		changed = true
+	}
+	if len(result) > limit {
+		result = result[len(result)-limit:]
+	}
+	return result, changed
+}
+
+// latestChange returns the most recent change of the package, nil if history
+// has no changes of the package.
+func latestChange(history []lifecyclev1alpha1.FirmwareChange, name string) *lifecyclev1alpha1.FirmwareChange {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Package == name {
+			return &history[i]
+		}
+	}
+	return nil
+}
@@ -5,7 +5,10 @@ package v1alpha1
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -13,45 +16,80 @@ import (
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
 	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
-	"github.com/ironcore-dev/lifecycle-manager/clientgo/applyconfiguration/lifecycle/v1alpha1"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/cache"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
 	"github.com/ironcore-dev/lifecycle-manager/internal/service/scheduler"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/apiutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/approvalutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/driftutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/selectorutil"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/uuidutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/versionutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/windowutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
-	AddPackageFailureReason = "package already in the list"
-	SetPackageFailureReason = "package is not in the list"
+	AddPackageFailureReason        = "package already in the list"
+	SetPackageFailureReason        = "package is not in the list"
+	QueueFullFailureReason         = "scheduler queue is full"
+	MaintenanceWindowFailureReason = "outside of maintenance window"
 
 	targetTypeMachine = "machine"
+
+	// ReasonOutOfBandChange is the reason of FirmwareDrift condition and
+	// event, which report packages changed out of band.
+	ReasonOutOfBandChange = "OutOfBandChange"
+
+	// DefaultHistoryLimit is the number of firmware changes kept in the
+	// history of the machine by default.
+	DefaultHistoryLimit = 100
 )
 
 type MachineService struct {
 	machinev1alpha1connect.UnimplementedMachineServiceHandler
-	c         *lifecycle.Clientset
+	c         lifecycle.Interface
 	scheduler *scheduler.Scheduler[*lifecyclev1alpha1.Machine]
 	horizon   time.Duration
 	namespace string
+	cache     *cache.Cache
+
+	historyLimit int
+	recorder     record.EventRecorder
 }
 
 type Option func(service *MachineService)
 
 func NewService(cfg *rest.Config, opts ...Option) *MachineService {
-	c := lifecycle.NewForConfigOrDie(cfg)
-	svc := &MachineService{c: c}
+	svc := &MachineService{historyLimit: DefaultHistoryLimit}
 	for _, opt := range opts {
 		opt(svc)
 	}
+	if svc.c == nil {
+		svc.c = lifecycle.NewForConfigOrDie(cfg)
+	}
 	return svc
 }
 
+// WithClientset overrides clientset created from rest config.
+func WithClientset(c lifecycle.Interface) Option {
+	return func(svc *MachineService) {
+		svc.c = c
+	}
+}
+
 func WithNamespace(namespace string) Option {
 	return func(svc *MachineService) {
 		svc.namespace = namespace
@@ -64,12 +102,38 @@ func WithHorizon(horizon time.Duration) Option {
 	}
 }
 
+// WithCache makes service read Machine objects from the informers' cache
+// instead of API server, if target namespace is watched.
+func WithCache(c *cache.Cache) Option {
+	return func(svc *MachineService) {
+		svc.cache = c
+	}
+}
+
 func WithScheduler(scheduler *scheduler.Scheduler[*lifecyclev1alpha1.Machine]) Option {
 	return func(svc *MachineService) {
 		svc.scheduler = scheduler
 	}
 }
 
+// WithEventRecorder makes service report events of Machine objects, e.g.
+// firmware drift. Events are not reported if recorder is not set.
+func WithEventRecorder(recorder record.EventRecorder) Option {
+	return func(svc *MachineService) {
+		svc.recorder = recorder
+	}
+}
+
+// WithHistoryLimit sets the number of firmware changes kept in the history of
+// the machine.
+func WithHistoryLimit(limit int) Option {
+	return func(svc *MachineService) {
+		if limit > 0 {
+			svc.historyLimit = limit
+		}
+	}
+}
+
 func (s *MachineService) StartScheduler(ctx context.Context) {
 	s.scheduler.Start(ctx)
 }
@@ -93,7 +157,7 @@ func (s *MachineService) ScanMachine(
 		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_UNSPECIFIED,
 	}
 
-	machine, err := s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	machine, err := s.getMachine(ctx, namespace, req.Name)
 	if err != nil {
 		errCode := connect.CodeInternal
 		if apierrors.IsNotFound(err) {
@@ -102,8 +166,8 @@ func (s *MachineService) ScanMachine(
 		return nil, connect.NewError(errCode, err)
 	}
 	key := uuidutil.UUIDFromObjectKey(types.NamespacedName{Name: req.Name, Namespace: namespace})
-	resp.Result = s.scheduler.Schedule(
-		scheduler.NewTask[*lifecyclev1alpha1.Machine](key, scheduler.ScanJob, machine, targetTypeMachine))
+	task := scheduler.NewTask[*lifecyclev1alpha1.Machine](key, scheduler.ScanJob, machine, targetTypeMachine)
+	resp.Result = s.scheduler.Schedule(task.WithInitiator(initiator(ctx)))
 	return connect.NewResponse(resp), nil
 }
 
@@ -123,7 +187,7 @@ func (s *MachineService) Install(
 		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_UNSPECIFIED,
 	}
 
-	machine, err := s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	machine, err := s.getMachine(ctx, namespace, req.Name)
 	if err != nil {
 		errCode := connect.CodeInternal
 		if apierrors.IsNotFound(err) {
@@ -131,15 +195,26 @@ func (s *MachineService) Install(
 		}
 		return nil, connect.NewError(errCode, err)
 	}
+	state, err := s.maintenanceWindow(ctx, machine, map[string]*lifecyclev1alpha1.MachineType{})
+	if err != nil {
+		return nil, err
+	}
+	if !state.Open {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			fmt.Errorf("machine %s: %s", req.Name, MaintenanceWindowFailureReason))
+	}
 	key := uuidutil.UUIDFromObjectKey(types.NamespacedName{Name: req.Name, Namespace: namespace})
-	resp.Result = s.scheduler.Schedule(
-		scheduler.NewTask[*lifecyclev1alpha1.Machine](key, scheduler.InstallJob, machine, targetTypeMachine))
+	task := scheduler.NewTask[*lifecyclev1alpha1.Machine](key, scheduler.InstallJob, machine, targetTypeMachine)
+	resp.Result = s.scheduler.Schedule(task.WithDeadline(state.ClosesAt).WithInitiator(initiator(ctx)))
 	return connect.NewResponse(resp), nil
 }
 
 // UpdateMachineStatus request initialized by the spawned Job and should update
 // the status of processed Machine. If request succeed, Job exits with exit code 0,
-// otherwise, Job will stop with non-zero exit code.
+// otherwise, Job will stop with non-zero exit code. Only fields of the status
+// owned by the job are merged, others are owned by the controller and kept.
+// Changes of installed packages are recorded in the history of the Machine,
+// the ones made out of band are reported with FirmwareDrift condition and event.
 func (s *MachineService) UpdateMachineStatus(
 	ctx context.Context,
 	c *connect.Request[machinev1alpha1.UpdateMachineStatusRequest],
@@ -152,29 +227,138 @@ func (s *MachineService) UpdateMachineStatus(
 		namespace = s.namespace
 	}
 
-	machine, err := s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	key := uuidutil.UUIDFromObjectKey(types.NamespacedName{Name: req.Name, Namespace: namespace})
+	// job is not tracked anymore if it outlived the active job cache
+	task, _ := s.scheduler.GetActiveJob(key)
+	now := time.Now()
+	var (
+		changes []lifecyclev1alpha1.FirmwareChange
+		drifts  []driftutil.Drift
+	)
+	machine, err := s.updateMachineStatus(ctx, namespace, req.Name, "",
+		func(machine *lifecyclev1alpha1.Machine) bool {
+			// changes are detected against the state update is based on
+			changes = firmwareChanges(machine.Status.InstalledPackages, req.Status.GetInstalledPackages(), task, now)
+			drifts = driftutil.Detect(machine.Status.DesiredPackages, changes)
+			mergeJobStatus(&machine.Status, req.Status, task.Type)
+			setFirmwareDriftCondition(machine, drifts, task.Type != scheduler.InstallJob, now)
+			return true
+		})
 	if err != nil {
-		errCode := connect.CodeInternal
-		if apierrors.IsNotFound(err) {
-			errCode = connect.CodeNotFound
-		}
-		return nil, connect.NewError(errCode, err)
+		return nil, connect.NewError(updateErrorCode(err), err)
 	}
-	machineApply := v1alpha1.Machine(machine.Name, machine.Namespace).
-		WithStatus(apiutil.MachineStatusToApplyConfiguration(req.Status))
-	if _, err = s.c.LifecycleV1alpha1().Machines(namespace).ApplyStatus(ctx, machineApply, metav1.ApplyOptions{
-		FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
-		Force:        true,
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	// history is supplementary, so failure to record it does not fail the job
+	if err = s.recordHistory(ctx, machine, changes); err != nil {
+		log.Error("failed to record machine history", "machine", req.Name, "namespace", namespace, "error", err)
 	}
-	key := uuidutil.UUIDFromObjectKey(types.NamespacedName{Name: req.Name, Namespace: namespace})
+	if len(drifts) > 0 && s.recorder != nil {
+		s.recorder.Event(machine, corev1.EventTypeWarning, ReasonOutOfBandChange,
+			"firmware changed out of band: "+driftutil.Message(drifts))
+	}
+	s.recordJobResult(machine, key, task.Type, req.Status)
 	s.scheduler.ForgetFinishedJob(key)
 	return connect.NewResponse(&machinev1alpha1.UpdateMachineStatusResponse{
 		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
 	}), nil
 }
 
+// mergeJobStatus merges fields owned by the job into machine's status. The
+// rest of the status reported by the job is a snapshot taken when the job was
+// scheduled, so it is ignored. Scan results are not taken from install jobs
+// and install results are not taken from scan jobs, since they are carried
+// over from the snapshot as well. Both are taken from jobs not tracked
+// anymore.
+func mergeJobStatus(
+	dst *lifecyclev1alpha1.MachineStatus,
+	src *machinev1alpha1.MachineStatus,
+	jobType scheduler.JobType,
+) {
+	if src == nil {
+		return
+	}
+	dst.InstalledPackages = apiutil.PackageVersionsToKubeAPI(src.InstalledPackages)
+	if jobType != scheduler.InstallJob {
+		dst.LastScanResult = apiutil.LCIMScanResultToString[src.LastScanResult]
+		if src.LastScanTime != nil {
+			dst.LastScanTime = metav1.Time{Time: time.Unix(src.LastScanTime.Seconds, int64(src.LastScanTime.Nanos))}
+		}
+	}
+	if jobType == scheduler.ScanJob {
+		return
+	}
+	if idx := slices.IndexFunc(src.Conditions, func(c *commonv1alpha1.Condition) bool {
+		return c.Type == lifecyclev1alpha1.MachineConditionInstallFailed
+	}); idx >= 0 {
+		meta.SetStatusCondition(&dst.Conditions, apiutil.ConditionToKubeAPI(src.Conditions[idx]))
+	}
+}
+
+// recordJobResult reports the result of the job with the event of the
+// machine. Jobs not tracked anymore are reported as scans.
+func (s *MachineService) recordJobResult(
+	machine *lifecyclev1alpha1.Machine,
+	key string,
+	jobType scheduler.JobType,
+	status *machinev1alpha1.MachineStatus,
+) {
+	if s.recorder == nil {
+		return
+	}
+	if jobType == scheduler.InstallJob {
+		if idx := slices.IndexFunc(status.GetConditions(), func(c *commonv1alpha1.Condition) bool {
+			return c.Type == lifecyclev1alpha1.MachineConditionInstallFailed && c.Status == string(metav1.ConditionTrue)
+		}); idx >= 0 {
+			s.recorder.Eventf(machine, corev1.EventTypeWarning, lifecyclev1alpha1.EventReasonInstallFailed,
+				"install job %s failed: %s", key, status.Conditions[idx].Message)
+			return
+		}
+		s.recorder.Eventf(machine, corev1.EventTypeNormal, lifecyclev1alpha1.EventReasonInstallCompleted,
+			"install job %s completed", key)
+		return
+	}
+	switch status.GetLastScanResult() {
+	case commonv1alpha1.ScanResult_SCAN_RESULT_SUCCESS:
+		s.recorder.Eventf(machine, corev1.EventTypeNormal, lifecyclev1alpha1.EventReasonScanCompleted,
+			"scan job %s completed", key)
+	case commonv1alpha1.ScanResult_SCAN_RESULT_FAILURE:
+		s.recorder.Eventf(machine, corev1.EventTypeWarning, lifecyclev1alpha1.EventReasonScanFailed,
+			"scan job %s failed", key)
+	}
+}
+
+// UpdatePackageStatus merges progress of installation of packages into
+// machine's status. Other packages and fields of the status are kept, so
+// updates of the status made concurrently are not overwritten.
+func (s *MachineService) UpdatePackageStatus(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.UpdatePackageStatusRequest],
+) (*connect.Response[machinev1alpha1.UpdatePackageStatusResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
+	}
+	if err := validatePackageStatuses(req.Packages...); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	updates := apiutil.PackageInstallStatusesToKubeAPI(req.Packages)
+	_, err := s.updateMachineStatus(ctx, namespace, req.Name, "",
+		func(machine *lifecyclev1alpha1.Machine) bool {
+			var changed bool
+			machine.Status.PackageStatuses, changed = upsertPackageStatuses(machine.Status.PackageStatuses, updates)
+			return changed
+		})
+	if err != nil {
+		return nil, connect.NewError(updateErrorCode(err), err)
+	}
+	return connect.NewResponse(&machinev1alpha1.UpdatePackageStatusResponse{
+		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+	}), nil
+}
+
 // ListMachines returns the list of Machine objects.
 func (s *MachineService) ListMachines(
 	ctx context.Context,
@@ -188,20 +372,62 @@ func (s *MachineService) ListMachines(
 		namespace = s.namespace
 	}
 
-	opts := metav1.ListOptions{}
-	reqSelector := req.GetLabelSelector()
-	if reqSelector != nil {
-		opts.LabelSelector = labels.Set(reqSelector.MatchLabels).String()
+	labelSelector, err := selectorutil.LabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	machines, err := s.c.LifecycleV1alpha1().Machines(namespace).List(ctx, opts)
+	fieldSelector, err := selectorutil.FieldSelector(
+		req.GetFieldSelector(), selectorutil.MachineFields(&lifecyclev1alpha1.Machine{}))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// unpaginated list is served from cache, paginated one relies on
+	// continue tokens of API server
+	if lister, ok := s.cache.Machines(namespace); ok && req.GetPageSize() == 0 && req.GetPageToken() == "" {
+		items, err := lister.List(labelSelector)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		slices.SortFunc(items, func(a, b *lifecyclev1alpha1.Machine) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		resp := &machinev1alpha1.ListMachinesResponse{}
+		for _, item := range items {
+			if !fieldSelector.Matches(selectorutil.MachineFields(item)) {
+				continue
+			}
+			resp.Machines = append(resp.Machines, apiutil.MachineToGrpcAPI(item.DeepCopy()))
+		}
+		return connect.NewResponse(resp), nil
 	}
-	resp := &machinev1alpha1.ListMachinesResponse{Machines: make([]*machinev1alpha1.Machine, len(machines.Items))}
-	for i, item := range machines.Items {
-		m := item.DeepCopy()
-		resp.Machines[i] = apiutil.MachineToGrpcAPI(m)
+
+	// objects not matching field selector are filtered out after listing,
+	// so list is repeated until the page is filled or no objects left
+	pageSize := req.GetPageSize()
+	resp := &machinev1alpha1.ListMachinesResponse{}
+	opts := metav1.ListOptions{LabelSelector: labelSelector.String(), Continue: req.GetPageToken()}
+	for {
+		if pageSize > 0 {
+			opts.Limit = pageSize - int64(len(resp.Machines))
+		}
+		machines, err := s.c.LifecycleV1alpha1().Machines(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, connect.NewError(listErrorCode(err), err)
+		}
+		for i := range machines.Items {
+			item := &machines.Items[i]
+			if !fieldSelector.Matches(selectorutil.MachineFields(item)) {
+				continue
+			}
+			resp.Machines = append(resp.Machines, apiutil.MachineToGrpcAPI(item))
+		}
+		opts.Continue = machines.Continue
+		if opts.Continue == "" || pageSize == 0 || int64(len(resp.Machines)) >= pageSize {
+			break
+		}
 	}
+	resp.NextPageToken = opts.Continue
 	return connect.NewResponse(resp), nil
 }
 
@@ -217,33 +443,34 @@ func (s *MachineService) AddPackageVersion(
 		namespace = s.namespace
 	}
 
-	machine, err := s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	if err := validatePackages(req.Package); err != nil {
+		return nil, err
+	}
+	var exists bool
+	machine, err := s.updateMachine(ctx, namespace, req.Name, req.ResourceVersion,
+		func(machine *lifecyclev1alpha1.Machine) bool {
+			pkg := apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
+			if exists = packageIndex(req.Package.Name, pkg) > -1; exists {
+				return false
+			}
+			pkg = slices.Grow(pkg, 1)
+			pkg = append(pkg, req.Package)
+			machine.Spec.Packages = apiutil.PackageVersionsToKubeAPI(pkg)
+			return true
+		})
 	if err != nil {
-		errCode := connect.CodeInternal
-		if apierrors.IsNotFound(err) {
-			errCode = connect.CodeNotFound
-		}
-		return nil, connect.NewError(errCode, err)
+		return nil, connect.NewError(updateErrorCode(err), err)
 	}
-	pkg := apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
-	if packageIndex(req.Package.Name, pkg) > -1 {
+	if exists {
 		return connect.NewResponse(&machinev1alpha1.AddPackageVersionResponse{
-			Reason: AddPackageFailureReason,
-			Result: commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+			Reason:          AddPackageFailureReason,
+			Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+			ResourceVersion: machine.ResourceVersion,
 		}), nil
 	}
-	pkg = slices.Grow(pkg, 1)
-	pkg = append(pkg, req.Package)
-	machineApply := v1alpha1.Machine(machine.Name, machine.Namespace).WithSpec(
-		v1alpha1.MachineSpec().WithPackages(apiutil.PackageVersionsToApplyConfiguration(pkg)...))
-	if _, err = s.c.LifecycleV1alpha1().Machines(namespace).Apply(ctx, machineApply, metav1.ApplyOptions{
-		FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
-		Force:        true,
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
 	return connect.NewResponse(&machinev1alpha1.AddPackageVersionResponse{
-		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		ResourceVersion: machine.ResourceVersion,
 	}), nil
 }
 
@@ -259,33 +486,34 @@ func (s *MachineService) SetPackageVersion(
 		namespace = s.namespace
 	}
 
-	machine, err := s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	if err := validatePackages(req.Package); err != nil {
+		return nil, err
+	}
+	var missing bool
+	machine, err := s.updateMachine(ctx, namespace, req.Name, req.ResourceVersion,
+		func(machine *lifecyclev1alpha1.Machine) bool {
+			pkg := apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
+			idx := packageIndex(req.Package.Name, pkg)
+			if missing = idx == -1; missing {
+				return false
+			}
+			pkg[idx] = req.Package
+			machine.Spec.Packages = apiutil.PackageVersionsToKubeAPI(pkg)
+			return true
+		})
 	if err != nil {
-		errCode := connect.CodeInternal
-		if apierrors.IsNotFound(err) {
-			errCode = connect.CodeNotFound
-		}
-		return nil, connect.NewError(errCode, err)
+		return nil, connect.NewError(updateErrorCode(err), err)
 	}
-	pkg := apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
-	var idx int
-	if idx = packageIndex(req.Package.Name, pkg); idx == -1 {
+	if missing {
 		return connect.NewResponse(&machinev1alpha1.SetPackageVersionResponse{
-			Reason: SetPackageFailureReason,
-			Result: commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+			Reason:          SetPackageFailureReason,
+			Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+			ResourceVersion: machine.ResourceVersion,
 		}), nil
 	}
-	pkg[idx] = req.Package
-	machineApply := v1alpha1.Machine(machine.Name, machine.Namespace).WithSpec(
-		v1alpha1.MachineSpec().WithPackages(apiutil.PackageVersionsToApplyConfiguration(pkg)...))
-	if _, err = s.c.LifecycleV1alpha1().Machines(namespace).Apply(ctx, machineApply, metav1.ApplyOptions{
-		FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
-		Force:        true,
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
 	return connect.NewResponse(&machinev1alpha1.SetPackageVersionResponse{
-		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		ResourceVersion: machine.ResourceVersion,
 	}), nil
 }
 
@@ -301,33 +529,256 @@ func (s *MachineService) RemovePackageVersion(
 		namespace = s.namespace
 	}
 
-	machine, err := s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	machine, err := s.updateMachine(ctx, namespace, req.Name, req.ResourceVersion,
+		func(machine *lifecyclev1alpha1.Machine) bool {
+			pkg := apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
+			idx := packageIndex(req.PackageName, pkg)
+			if idx == -1 {
+				return false
+			}
+			machine.Spec.Packages = apiutil.PackageVersionsToKubeAPI(removePackage(pkg, idx))
+			return true
+		})
 	if err != nil {
-		errCode := connect.CodeInternal
-		if apierrors.IsNotFound(err) {
-			errCode = connect.CodeNotFound
-		}
-		return nil, connect.NewError(errCode, err)
+		return nil, connect.NewError(updateErrorCode(err), err)
 	}
-	pkg := apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
-	var idx int
-	if idx = packageIndex(req.PackageName, pkg); idx == -1 {
-		return connect.NewResponse(&machinev1alpha1.RemovePackageVersionResponse{
+	return connect.NewResponse(&machinev1alpha1.RemovePackageVersionResponse{
+		Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		ResourceVersion: machine.ResourceVersion,
+	}), nil
+}
+
+// ScanMachines schedules scan jobs for all Machine objects matching either
+// label selector, or machine type and group reference, or both.
+func (s *MachineService) ScanMachines(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.ScanMachinesRequest],
+) (*connect.Response[machinev1alpha1.ScanMachinesResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
+	}
+
+	machines, err := s.selectMachines(ctx, namespace, req.GetLabelSelector(), req.GetMachineGroupRef())
+	if err != nil {
+		return nil, err
+	}
+	resp := &machinev1alpha1.ScanMachinesResponse{
+		Results: s.scheduleAll(ctx, machines, scheduler.ScanJob),
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// InstallMachines schedules package installation for all Machine objects
+// matching either label selector, or machine type and group reference, or both.
+func (s *MachineService) InstallMachines(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.InstallMachinesRequest],
+) (*connect.Response[machinev1alpha1.InstallMachinesResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
+	}
+
+	machines, err := s.selectMachines(ctx, namespace, req.GetLabelSelector(), req.GetMachineGroupRef())
+	if err != nil {
+		return nil, err
+	}
+	resp := &machinev1alpha1.InstallMachinesResponse{
+		Results: s.scheduleAll(ctx, machines, scheduler.InstallJob),
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SetPackageVersions sets desired versions of the packages for all Machine
+// objects matching either label selector, or machine type and group reference,
+// or both. Packages missing in Machine's spec are added. In dry run mode no
+// changes are written, response contains the diff only.
+func (s *MachineService) SetPackageVersions(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.SetPackageVersionsRequest],
+) (*connect.Response[machinev1alpha1.SetPackageVersionsResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
+	}
+
+	if err := validatePackages(req.Packages...); err != nil {
+		return nil, err
+	}
+	machines, err := s.selectMachines(ctx, namespace, req.GetLabelSelector(), req.GetMachineGroupRef())
+	if err != nil {
+		return nil, err
+	}
+	resp := &machinev1alpha1.SetPackageVersionsResponse{
+		Results: make([]*machinev1alpha1.MachinePackagesDiff, len(machines)),
+	}
+	for i, machine := range machines {
+		diff := &machinev1alpha1.MachinePackagesDiff{
+			Name:   machine.Name,
+			Before: apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages),
 			Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
-		}), nil
+		}
+		resp.Results[i] = diff
+		if req.DryRun {
+			packages, _ := upsertPackages(machine.Spec.Packages, req.Packages)
+			diff.After = apiutil.PackageVersionsToGrpcAPI(packages)
+			continue
+		}
+		updated, err := s.updateMachine(ctx, namespace, machine.Name, "",
+			func(machine *lifecyclev1alpha1.Machine) bool {
+				// diff is taken from the state update is based on
+				diff.Before = apiutil.PackageVersionsToGrpcAPI(machine.Spec.Packages)
+				var changed bool
+				machine.Spec.Packages, changed = upsertPackages(machine.Spec.Packages, req.Packages)
+				return changed
+			})
+		if err != nil {
+			log.Error("failed to set package versions", "machine", machine.Name, "error", err.Error())
+			diff.Result = commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE
+			diff.Reason = err.Error()
+			diff.After = diff.Before
+			continue
+		}
+		diff.After = apiutil.PackageVersionsToGrpcAPI(updated.Spec.Packages)
 	}
-	pkg = removePackage(pkg, idx)
-	machineApply := v1alpha1.Machine(machine.Name, machine.Namespace).WithSpec(
-		v1alpha1.MachineSpec().WithPackages(apiutil.PackageVersionsToApplyConfiguration(pkg)...))
-	if _, err = s.c.LifecycleV1alpha1().Machines(namespace).Apply(ctx, machineApply, metav1.ApplyOptions{
-		FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
-		Force:        true,
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	return connect.NewResponse(resp), nil
+}
+
+// GetInstallPlan returns install plans either for the single Machine object,
+// or for Machine objects matching label selector, or machine type and group
+// reference, or both. Plan consists of effective desired packages with their
+// source, installed packages and packages pending installation.
+func (s *MachineService) GetInstallPlan(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.GetInstallPlanRequest],
+) (*connect.Response[machinev1alpha1.GetInstallPlanResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
 	}
-	return connect.NewResponse(&machinev1alpha1.RemovePackageVersionResponse{
-		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
-	}), nil
+
+	var machines []*lifecyclev1alpha1.Machine
+	if req.Name != "" {
+		machine, err := s.getMachine(ctx, namespace, req.Name)
+		if err != nil {
+			errCode := connect.CodeInternal
+			if apierrors.IsNotFound(err) {
+				errCode = connect.CodeNotFound
+			}
+			return nil, connect.NewError(errCode, err)
+		}
+		machines = append(machines, machine)
+	} else {
+		var err error
+		machines, err = s.selectMachines(ctx, namespace, req.GetLabelSelector(), req.GetMachineGroupRef())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &machinev1alpha1.GetInstallPlanResponse{
+		Plans: make([]*machinev1alpha1.InstallPlan, len(machines)),
+	}
+	machineTypes := make(map[string]*lifecyclev1alpha1.MachineType)
+	for i, machine := range machines {
+		plan := &machinev1alpha1.InstallPlan{
+			Name:      machine.Name,
+			Installed: apiutil.PackageVersionsToGrpcAPI(machine.Status.InstalledPackages),
+		}
+		resp.Plans[i] = plan
+		machineType, ok := machineTypes[machine.Spec.MachineTypeRef.Name]
+		if !ok {
+			var err error
+			machineType, err = s.getMachineType(ctx, namespace, machine.Spec.MachineTypeRef.Name)
+			switch {
+			case apierrors.IsNotFound(err):
+				// typed client returns empty object rather than nil
+				machineType = nil
+			case err != nil:
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			machineTypes[machine.Spec.MachineTypeRef.Name] = machineType
+		}
+		if machineType == nil {
+			plan.Reason = fmt.Sprintf("machine type %q not found", machine.Spec.MachineTypeRef.Name)
+			continue
+		}
+		computed, err := planutil.Compute(machine, machineType)
+		if err != nil {
+			plan.Reason = err.Error()
+			continue
+		}
+		plan.Packages = apiutil.DesiredPackagesToGrpcAPI(computed.Desired)
+		plan.Pending = apiutil.PackageVersionsToGrpcAPI(computed.Pending)
+		reasons := make([]string, len(computed.Unresolved))
+		for j, pv := range computed.Unresolved {
+			reasons[j] = pv.Unresolved
+		}
+		plan.Reason = strings.Join(reasons, "; ")
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ApproveInstall approves or rejects the pending installation of the machine
+// on behalf of the authenticated caller, or the approver of the request if
+// authorization is disabled. The decision is recorded in machine's status.
+func (s *MachineService) ApproveInstall(
+	ctx context.Context,
+	c *connect.Request[machinev1alpha1.ApproveInstallRequest],
+) (*connect.Response[machinev1alpha1.ApproveInstallResponse], error) {
+	log := logr.FromContextAsSlogLogger(ctx)
+	log.Info("request", "request_body", c.Any())
+	req := c.Msg
+	namespace := req.GetNamespace()
+	if namespace == "" {
+		namespace = s.namespace
+	}
+	approver := req.Approver
+	if user, ok := interceptor.UserFromContext(ctx); ok {
+		approver = user.Username
+	}
+	if approver == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("approver must be specified"))
+	}
+	state := lifecyclev1alpha1.ApprovalStateApproved
+	if req.Reject {
+		state = lifecyclev1alpha1.ApprovalStateRejected
+	}
+
+	var decideErr error
+	machine, err := s.updateMachineStatus(ctx, namespace, req.Name, "",
+		func(machine *lifecyclev1alpha1.Machine) bool {
+			decideErr = approvalutil.Decide(machine, state, approver, req.Reason, req.Revision, time.Now())
+			return decideErr == nil
+		})
+	if err != nil {
+		return nil, connect.NewError(updateErrorCode(err), err)
+	}
+	resp := &machinev1alpha1.ApproveInstallResponse{
+		Result:   commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		Approval: apiutil.InstallApprovalToGrpcAPI(machine.Status.Approval),
+	}
+	if decideErr != nil {
+		resp.Result = commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE
+		resp.Reason = decideErr.Error()
+		return connect.NewResponse(resp), nil
+	}
+	log.Info("install approval decided", "machine", req.Name, "namespace", namespace,
+		"state", state, "user", approver, "revision", machine.Status.Approval.Revision, "reason", req.Reason)
+	return connect.NewResponse(resp), nil
 }
 
 func (s *MachineService) GetJob(
@@ -347,12 +798,62 @@ func (s *MachineService) GetJob(
 	}), nil
 }
 
+// upsertPackageStatuses returns a copy of statuses with entries replaced by
+// updates of the same package. Packages missing in the list are appended.
+// The second return value reports whether any entry was changed.
+func upsertPackageStatuses(
+	statuses []lifecyclev1alpha1.PackageInstallStatus,
+	updates []lifecyclev1alpha1.PackageInstallStatus,
+) ([]lifecyclev1alpha1.PackageInstallStatus, bool) {
+	result := slices.Clone(statuses)
+	var changed bool
+	for _, update := range updates {
+		idx := slices.IndexFunc(result, func(item lifecyclev1alpha1.PackageInstallStatus) bool {
+			return item.Name == update.Name
+		})
+		switch {
+		case idx < 0:
+			result = append(result, update)
+			changed = true
+		case !equality.Semantic.DeepEqual(result[idx], update):
+			result[idx] = update
+			changed = true
+		}
+	}
+	return result, changed
+}
+
 func packageIndex(pkg string, dst []*commonv1alpha1.PackageVersion) int {
 	return slices.IndexFunc(dst, func(pv *commonv1alpha1.PackageVersion) bool {
 		return pkg == pv.Name
 	})
 }
 
+// upsertPackages returns a copy of packages with versions set according to
+// updates. Packages missing in the list are appended. The second return value
+// reports whether any version was changed.
+func upsertPackages(
+	packages []lifecyclev1alpha1.PackageVersion,
+	updates []*commonv1alpha1.PackageVersion,
+) ([]lifecyclev1alpha1.PackageVersion, bool) {
+	result := slices.Clone(packages)
+	var changed bool
+	for _, update := range updates {
+		idx := slices.IndexFunc(result, func(pkg lifecyclev1alpha1.PackageVersion) bool {
+			return pkg.Name == update.Name
+		})
+		switch {
+		case idx == -1:
+			result = append(result, lifecyclev1alpha1.PackageVersion{Name: update.Name, Version: update.Version})
+			changed = true
+		case result[idx].Version != update.Version:
+			result[idx].Version = update.Version
+			changed = true
+		}
+	}
+	return result, changed
+}
+
 func removePackage(src []*commonv1alpha1.PackageVersion, index int) []*commonv1alpha1.PackageVersion {
 	result := make([]*commonv1alpha1.PackageVersion, 0, len(src)-1)
 	if len(src) == 1 {
@@ -365,3 +866,375 @@ func removePackage(src []*commonv1alpha1.PackageVersion, index int) []*commonv1a
 	result = append(result, src[index+1:]...)
 	return result
 }
+
+func listErrorCode(err error) connect.Code {
+	switch {
+	case apierrors.IsResourceExpired(err):
+		return connect.CodeFailedPrecondition
+	case apierrors.IsBadRequest(err):
+		return connect.CodeInvalidArgument
+	}
+	return connect.CodeInternal
+}
+
+func (s *MachineService) getMachine(ctx context.Context, namespace, name string) (*lifecyclev1alpha1.Machine, error) {
+	if lister, ok := s.cache.Machines(namespace); ok {
+		obj, err := lister.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return obj.DeepCopy(), nil
+	}
+	return s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// updateMachine applies mutate to the Machine and updates it in API server.
+// Non-empty resourceVersion is used as precondition, so conflict is returned
+// to the caller. Otherwise, update is retried on conflict against the latest
+// state of the Machine. If mutate returns false, update is skipped.
+func (s *MachineService) updateMachine(
+	ctx context.Context,
+	namespace, name, resourceVersion string,
+	mutate func(*lifecyclev1alpha1.Machine) bool,
+) (*lifecyclev1alpha1.Machine, error) {
+	return s.update(ctx, namespace, name, resourceVersion, mutate,
+		s.c.LifecycleV1alpha1().Machines(namespace).Update)
+}
+
+// updateMachineStatus is like updateMachine, but updates status of the Machine.
+func (s *MachineService) updateMachineStatus(
+	ctx context.Context,
+	namespace, name, resourceVersion string,
+	mutate func(*lifecyclev1alpha1.Machine) bool,
+) (*lifecyclev1alpha1.Machine, error) {
+	return s.update(ctx, namespace, name, resourceVersion, mutate,
+		s.c.LifecycleV1alpha1().Machines(namespace).UpdateStatus)
+}
+
+func (s *MachineService) update(
+	ctx context.Context,
+	namespace, name, resourceVersion string,
+	mutate func(*lifecyclev1alpha1.Machine) bool,
+	write func(context.Context, *lifecyclev1alpha1.Machine, metav1.UpdateOptions) (*lifecyclev1alpha1.Machine, error),
+) (*lifecyclev1alpha1.Machine, error) {
+	var (
+		result  *lifecyclev1alpha1.Machine
+		attempt int
+	)
+	update := func() error {
+		var (
+			machine *lifecyclev1alpha1.Machine
+			err     error
+		)
+		// cache might lag behind, so retries and preconditions use the API server
+		if attempt == 0 && resourceVersion == "" {
+			machine, err = s.getMachine(ctx, namespace, name)
+		} else {
+			machine, err = s.c.LifecycleV1alpha1().Machines(namespace).Get(ctx, name, metav1.GetOptions{})
+		}
+		attempt++
+		if err != nil {
+			return err
+		}
+		if resourceVersion != "" && machine.ResourceVersion != resourceVersion {
+			return apierrors.NewConflict(lifecyclev1alpha1.Resource("machines"), name,
+				fmt.Errorf("resource version %s does not match %s", resourceVersion, machine.ResourceVersion))
+		}
+		if !mutate(machine) {
+			result = machine
+			return nil
+		}
+		result, err = write(ctx, machine, metav1.UpdateOptions{
+			FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
+		})
+		return err
+	}
+	var err error
+	if resourceVersion != "" {
+		err = update()
+	} else {
+		err = retry.RetryOnConflict(retry.DefaultRetry, update)
+	}
+	return result, err
+}
+
+// setFirmwareDriftCondition reports drifted packages in machine's status.
+// The condition is not removed here, since following scans find no changes
+// while the drift is not resolved. The controller removes it once the machine
+// has no pending packages. Transition time is kept while the drift is not
+// resolved. Changes of packages, which are not managed, are reported with
+// their own reason, which neither replaces unresolved drift of desired
+// packages nor blocks installation, and are reported until the next scan.
+func setFirmwareDriftCondition(
+	machine *lifecyclev1alpha1.Machine,
+	drifts []driftutil.Drift,
+	scanned bool,
+	now time.Time,
+) {
+	current := meta.FindStatusCondition(machine.Status.Conditions, lifecyclev1alpha1.MachineConditionFirmwareDrift)
+	unmanaged := current != nil && current.Reason == driftutil.ReasonUnmanagedChange
+	reason := ReasonOutOfBandChange
+	switch {
+	case len(drifts) == 0:
+		if scanned && unmanaged {
+			meta.RemoveStatusCondition(&machine.Status.Conditions, lifecyclev1alpha1.MachineConditionFirmwareDrift)
+		}
+		return
+	case slices.ContainsFunc(drifts, driftutil.Drift.Managed):
+	case driftutil.Drifted(machine):
+		return
+	default:
+		reason = driftutil.ReasonUnmanagedChange
+	}
+	if unmanaged != (reason == driftutil.ReasonUnmanagedChange) {
+		// transition time reflects the drift of desired packages
+		meta.RemoveStatusCondition(&machine.Status.Conditions, lifecyclev1alpha1.MachineConditionFirmwareDrift)
+	}
+	meta.SetStatusCondition(&machine.Status.Conditions, metav1.Condition{
+		Type:               lifecyclev1alpha1.MachineConditionFirmwareDrift,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: machine.Generation,
+		LastTransitionTime: metav1.NewTime(now),
+		Reason:             reason,
+		Message:            driftutil.Message(drifts),
+	})
+}
+
+// initiator returns the name of the authenticated caller, empty if requests
+// are not authenticated.
+func initiator(ctx context.Context) string {
+	if user, ok := interceptor.UserFromContext(ctx); ok {
+		return user.Username
+	}
+	return ""
+}
+
+// validatePackageStatuses ensures states of package statuses are known.
+func validatePackageStatuses(statuses ...*machinev1alpha1.PackageInstallStatus) error {
+	for _, item := range statuses {
+		switch lifecyclev1alpha1.PackageInstallState(item.State) {
+		case lifecyclev1alpha1.PackageInstallStatePending,
+			lifecyclev1alpha1.PackageInstallStateDownloading,
+			lifecyclev1alpha1.PackageInstallStateInstalling,
+			lifecyclev1alpha1.PackageInstallStateAwaitingReboot,
+			lifecyclev1alpha1.PackageInstallStateSucceeded,
+			lifecyclev1alpha1.PackageInstallStateFailed:
+		default:
+			return fmt.Errorf("invalid state %q of package %s", item.State, item.Name)
+		}
+	}
+	return nil
+}
+
+// validatePackages ensures versions of packages are either exact versions or
+// valid constraint expressions.
+func validatePackages(packages ...*commonv1alpha1.PackageVersion) error {
+	for _, pv := range packages {
+		if err := versionutil.Validate(pv.GetVersion()); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("package %s: %w", pv.GetName(), err))
+		}
+	}
+	return nil
+}
+
+func updateErrorCode(err error) connect.Code {
+	switch {
+	case apierrors.IsNotFound(err):
+		return connect.CodeNotFound
+	case apierrors.IsConflict(err):
+		return connect.CodeAborted
+	}
+	return connect.CodeInternal
+}
+
+// selectMachines returns Machine objects matching label selector and
+// belonging to referenced machine type and group, sorted by name.
+func (s *MachineService) selectMachines(
+	ctx context.Context,
+	namespace string,
+	labelSelector *metav1.LabelSelector,
+	ref *machinev1alpha1.MachineGroupReference,
+) ([]*lifecyclev1alpha1.Machine, error) {
+	if ref != nil && ref.MachineType == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			errors.New("machine type of machine group reference must not be empty"))
+	}
+	selector, err := selectorutil.LabelSelector(labelSelector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var machineType *lifecyclev1alpha1.MachineType
+	if ref != nil && ref.MachineGroup != "" {
+		var groupSelector labels.Selector
+		machineType, groupSelector, err = s.machineGroupSelector(ctx, namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		requirements, _ := groupSelector.Requirements()
+		selector = selector.Add(requirements...)
+	}
+	machines, err := s.listMachines(ctx, namespace, selector)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if ref == nil {
+		return machines, nil
+	}
+	return slices.DeleteFunc(machines, func(machine *lifecyclev1alpha1.Machine) bool {
+		if machine.Spec.MachineTypeRef.Name != ref.MachineType {
+			return true
+		}
+		if machineType == nil {
+			return false
+		}
+		// group selector matches machines captured by group of higher priority
+		// too, so only machines effectively belonging to the group are kept
+		group, err := planutil.MachineGroup(machine, machineType)
+		return err != nil || group == nil || group.Name != ref.MachineGroup
+	}), nil
+}
+
+// machineGroupSelector returns referenced machine type and selector of its
+// machine group.
+func (s *MachineService) machineGroupSelector(
+	ctx context.Context,
+	namespace string,
+	ref *machinev1alpha1.MachineGroupReference,
+) (*lifecyclev1alpha1.MachineType, labels.Selector, error) {
+	machineType, err := s.getMachineType(ctx, namespace, ref.MachineType)
+	if err != nil {
+		errCode := connect.CodeInternal
+		if apierrors.IsNotFound(err) {
+			errCode = connect.CodeNotFound
+		}
+		return nil, nil, connect.NewError(errCode, err)
+	}
+	idx := slices.IndexFunc(machineType.Spec.MachineGroups, func(group lifecyclev1alpha1.MachineGroup) bool {
+		return group.Name == ref.MachineGroup
+	})
+	if idx == -1 {
+		return nil, nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("machine group %q not found in machine type %q", ref.MachineGroup, ref.MachineType))
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&machineType.Spec.MachineGroups[idx].MachineSelector)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return machineType, selector, nil
+}
+
+func (s *MachineService) getMachineType(
+	ctx context.Context,
+	namespace, name string,
+) (*lifecyclev1alpha1.MachineType, error) {
+	if lister, ok := s.cache.MachineTypes(namespace); ok {
+		return lister.Get(name)
+	}
+	return s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (s *MachineService) listMachines(
+	ctx context.Context,
+	namespace string,
+	selector labels.Selector,
+) ([]*lifecyclev1alpha1.Machine, error) {
+	var result []*lifecyclev1alpha1.Machine
+	if lister, ok := s.cache.Machines(namespace); ok {
+		items, err := lister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		result = make([]*lifecyclev1alpha1.Machine, len(items))
+		for i, item := range items {
+			result[i] = item.DeepCopy()
+		}
+	} else {
+		machines, err := s.c.LifecycleV1alpha1().Machines(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: selector.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = make([]*lifecyclev1alpha1.Machine, len(machines.Items))
+		for i := range machines.Items {
+			result[i] = &machines.Items[i]
+		}
+	}
+	slices.SortFunc(result, func(a, b *lifecyclev1alpha1.Machine) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result, nil
+}
+
+// scheduleAll schedules jobs for machines. Install jobs are scheduled only
+// for machines within their maintenance windows and must be started before
+// the window closes.
+func (s *MachineService) scheduleAll(
+	ctx context.Context,
+	machines []*lifecyclev1alpha1.Machine,
+	jobType scheduler.JobType,
+) []*machinev1alpha1.MachineRequestResult {
+	results := make([]*machinev1alpha1.MachineRequestResult, len(machines))
+	machineTypes := make(map[string]*lifecyclev1alpha1.MachineType)
+	for i, machine := range machines {
+		key := uuidutil.UUIDFromObjectKey(types.NamespacedName{Name: machine.Name, Namespace: machine.Namespace})
+		task := scheduler.NewTask[*lifecyclev1alpha1.Machine](key, jobType, machine, targetTypeMachine).
+			WithInitiator(initiator(ctx))
+		if jobType == scheduler.InstallJob {
+			state, err := s.maintenanceWindow(ctx, machine, machineTypes)
+			if err != nil || !state.Open {
+				results[i] = &machinev1alpha1.MachineRequestResult{
+					Name:   machine.Name,
+					Result: commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+					Reason: MaintenanceWindowFailureReason,
+				}
+				if err != nil {
+					results[i].Reason = err.Error()
+				}
+				continue
+			}
+			task = task.WithDeadline(state.ClosesAt)
+		}
+		result := s.scheduler.Schedule(task)
+		results[i] = &machinev1alpha1.MachineRequestResult{Name: machine.Name, Result: result}
+		if result == commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE {
+			results[i].Reason = QueueFullFailureReason
+		}
+	}
+	return results
+}
+
+// maintenanceWindow returns the state of maintenance windows applying to the
+// machine. Machine types are cached in the given map. Machines which machine
+// type is not found are not restricted by windows.
+func (s *MachineService) maintenanceWindow(
+	ctx context.Context,
+	machine *lifecyclev1alpha1.Machine,
+	machineTypes map[string]*lifecyclev1alpha1.MachineType,
+) (windowutil.State, error) {
+	name := machine.Spec.MachineTypeRef.Name
+	machineType, ok := machineTypes[name]
+	if !ok {
+		var err error
+		machineType, err = s.getMachineType(ctx, machine.Namespace, name)
+		switch {
+		case apierrors.IsNotFound(err):
+			machineType = nil
+		case err != nil:
+			return windowutil.State{}, connect.NewError(connect.CodeInternal, err)
+		}
+		machineTypes[name] = machineType
+	}
+	if machineType == nil {
+		return windowutil.State{Open: true}, nil
+	}
+	group, err := planutil.MachineGroup(machine, machineType)
+	if err != nil {
+		return windowutil.State{}, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	state, err := windowutil.Check(machine, machineType, group, time.Now())
+	if err != nil {
+		return windowutil.State{}, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return state, nil
+}
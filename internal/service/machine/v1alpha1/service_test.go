@@ -0,0 +1,849 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle/fake"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/cache"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/scheduler"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/approvalutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/driftutil"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Machine Service", func() {
+	var (
+		clientset *fake.Clientset
+		svc       *MachineService
+	)
+	ctx := logr.NewContextWithSlogLogger(context.Background(), slog.New(slog.NewTextHandler(GinkgoWriter, nil)))
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset(&lifecyclev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: "sample-machine", Namespace: "metal", ResourceVersion: "1"},
+			Spec: lifecyclev1alpha1.MachineSpec{
+				Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+			},
+		})
+		svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"))
+	})
+
+	getMachine := func() *lifecyclev1alpha1.Machine {
+		machine, err := clientset.LifecycleV1alpha1().Machines("metal").
+			Get(ctx, "sample-machine", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return machine
+	}
+
+	Context("Package versions", func() {
+		It("Should add package version", func() {
+			resp, err := svc.AddPackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.AddPackageVersionRequest{
+					Name:    "sample-machine",
+					Package: &commonv1alpha1.PackageVersion{Name: "bmc", Version: "2.0.0"},
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Expect(getMachine().Spec.Packages).To(ConsistOf(
+				lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"},
+				lifecyclev1alpha1.PackageVersion{Name: "bmc", Version: "2.0.0"}))
+		})
+
+		It("Should not add package version twice", func() {
+			resp, err := svc.AddPackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.AddPackageVersionRequest{
+					Name:    "sample-machine",
+					Package: &commonv1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"},
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE))
+			Expect(resp.Msg.Reason).To(Equal(AddPackageFailureReason))
+			Expect(resp.Msg.ResourceVersion).To(Equal("1"))
+		})
+
+		It("Should reject invalid version constraint", func() {
+			_, err := svc.AddPackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.AddPackageVersionRequest{
+					Name:    "sample-machine",
+					Package: &commonv1alpha1.PackageVersion{Name: "bmc", Version: ">=two"},
+				}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+
+			_, err = svc.SetPackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.SetPackageVersionRequest{
+					Name:    "sample-machine",
+					Package: &commonv1alpha1.PackageVersion{Name: "bios", Version: "~2.8"},
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getMachine().Spec.Packages).To(ConsistOf(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "~2.8"}))
+		})
+
+		It("Should set and remove package version", func() {
+			_, err := svc.SetPackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.SetPackageVersionRequest{
+					Name:            "sample-machine",
+					Package:         &commonv1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"},
+					ResourceVersion: "1",
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getMachine().Spec.Packages).To(ConsistOf(
+				lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"}))
+
+			_, err = svc.RemovePackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.RemovePackageVersionRequest{Name: "sample-machine", PackageName: "bios"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getMachine().Spec.Packages).To(BeEmpty())
+		})
+
+		It("Should abort on resource version mismatch", func() {
+			_, err := svc.SetPackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.SetPackageVersionRequest{
+					Name:            "sample-machine",
+					Package:         &commonv1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"},
+					ResourceVersion: "0",
+				}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeAborted))
+			Expect(getMachine().Spec.Packages).To(ConsistOf(
+				lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "1.0.0"}))
+		})
+
+		It("Should abort on conflict if precondition is set", func() {
+			clientset.PrependReactor("update", "machines", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewConflict(lifecyclev1alpha1.Resource("machines"), "sample-machine", nil)
+			})
+			_, err := svc.RemovePackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.RemovePackageVersionRequest{
+					Name:            "sample-machine",
+					PackageName:     "bios",
+					ResourceVersion: "1",
+				}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeAborted))
+		})
+
+		It("Should retry on conflict if precondition is not set", func() {
+			conflicts := 2
+			clientset.PrependReactor("update", "machines", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if conflicts == 0 {
+					return false, nil, nil
+				}
+				conflicts--
+				return true, nil, apierrors.NewConflict(lifecyclev1alpha1.Resource("machines"), "sample-machine", nil)
+			})
+			_, err := svc.RemovePackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.RemovePackageVersionRequest{Name: "sample-machine", PackageName: "bios"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conflicts).To(BeZero())
+			Expect(getMachine().Spec.Packages).To(BeEmpty())
+		})
+
+		It("Should return not found for missing machine", func() {
+			_, err := svc.RemovePackageVersion(ctx, connect.NewRequest(
+				&machinev1alpha1.RemovePackageVersionRequest{Name: "missing", PackageName: "bios"}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+	})
+
+	Context("Bulk scheduling", func() {
+		newMachine := func(name, machineType string, labels map[string]string) *lifecyclev1alpha1.Machine {
+			return &lifecyclev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "metal", Labels: labels},
+				Spec: lifecyclev1alpha1.MachineSpec{
+					MachineTypeRef: corev1.LocalObjectReference{Name: machineType},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			clientset = fake.NewSimpleClientset(
+				newMachine("machine-1", "type-a", map[string]string{"rack": "r1", "env": "prod"}),
+				newMachine("machine-2", "type-a", map[string]string{"rack": "r1", "env": "canary"}),
+				newMachine("machine-3", "type-a", map[string]string{"rack": "r2", "env": "prod"}),
+				newMachine("machine-4", "type-b", map[string]string{"rack": "r1", "env": "prod"}),
+				&lifecyclev1alpha1.MachineType{
+					ObjectMeta: metav1.ObjectMeta{Name: "type-a", Namespace: "metal"},
+					Spec: lifecyclev1alpha1.MachineTypeSpec{
+						MachineGroups: []lifecyclev1alpha1.MachineGroup{{
+							Name:            "production",
+							MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						}},
+					},
+				},
+			)
+			// two workers and one pending task slot
+			machineScheduler := scheduler.NewScheduler[*lifecyclev1alpha1.Machine](
+				slog.New(slog.NewTextHandler(GinkgoWriter, nil)), &rest.Config{}, "metal",
+				scheduler.WithWorkerCount[*lifecyclev1alpha1.Machine](2),
+				scheduler.WithActiveJobCache[*lifecyclev1alpha1.Machine](2, time.Minute),
+				scheduler.WithQueueCapacity[*lifecyclev1alpha1.Machine](1))
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"), WithScheduler(machineScheduler))
+		})
+
+		It("Should schedule scan for machines matching label selector", func() {
+			resp, err := svc.ScanMachines(ctx, connect.NewRequest(&machinev1alpha1.ScanMachinesRequest{
+				LabelSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "rack", Operator: metav1.LabelSelectorOpIn, Values: []string{"r1"}},
+					},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(3))
+			Expect(resp.Msg.Results[0].Name).To(Equal("machine-1"))
+			Expect(resp.Msg.Results[0].Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Expect(resp.Msg.Results[1].Name).To(Equal("machine-2"))
+			Expect(resp.Msg.Results[2].Name).To(Equal("machine-4"))
+		})
+
+		It("Should report machines rejected due to full queue", func() {
+			resp, err := svc.ScanMachines(ctx, connect.NewRequest(&machinev1alpha1.ScanMachinesRequest{
+				LabelSelector: &metav1.LabelSelector{},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(4))
+			Expect(resp.Msg.Results[3].Name).To(Equal("machine-4"))
+			Expect(resp.Msg.Results[3].Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE))
+			Expect(resp.Msg.Results[3].Reason).To(Equal(QueueFullFailureReason))
+
+			resp, err = svc.ScanMachines(ctx, connect.NewRequest(&machinev1alpha1.ScanMachinesRequest{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rack": "r2"}},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(1))
+			Expect(resp.Msg.Results[0].Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SCHEDULED))
+		})
+
+		It("Should schedule install for machines in machine group", func() {
+			resp, err := svc.InstallMachines(ctx, connect.NewRequest(&machinev1alpha1.InstallMachinesRequest{
+				MachineGroupRef: &machinev1alpha1.MachineGroupReference{
+					MachineType:  "type-a",
+					MachineGroup: "production",
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(2))
+			Expect(resp.Msg.Results[0].Name).To(Equal("machine-1"))
+			Expect(resp.Msg.Results[1].Name).To(Equal("machine-3"))
+		})
+
+		It("Should skip machines belonging to machine group of higher priority", func() {
+			machineType, err := clientset.LifecycleV1alpha1().MachineTypes("metal").Get(ctx, "type-a", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			machineType.Spec.MachineGroups = append(machineType.Spec.MachineGroups, lifecyclev1alpha1.MachineGroup{
+				Name:            "rack-r2",
+				Priority:        10,
+				MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"rack": "r2"}},
+			})
+			_, err = clientset.LifecycleV1alpha1().MachineTypes("metal").Update(ctx, machineType, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := svc.InstallMachines(ctx, connect.NewRequest(&machinev1alpha1.InstallMachinesRequest{
+				MachineGroupRef: &machinev1alpha1.MachineGroupReference{
+					MachineType:  "type-a",
+					MachineGroup: "production",
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(1))
+			Expect(resp.Msg.Results[0].Name).To(Equal("machine-1"))
+		})
+
+		It("Should reject install for machines outside of maintenance window", func() {
+			opensAt := time.Now().UTC().Add(2 * time.Hour)
+			_, err := clientset.LifecycleV1alpha1().MachineTypes("metal").Create(ctx, &lifecyclev1alpha1.MachineType{
+				ObjectMeta: metav1.ObjectMeta{Name: "type-b", Namespace: "metal"},
+				Spec: lifecyclev1alpha1.MachineTypeSpec{
+					MaintenanceWindows: []lifecyclev1alpha1.MaintenanceWindow{{
+						Name:            "rack-r1",
+						Schedule:        fmt.Sprintf("%d %d * * *", opensAt.Minute(), opensAt.Hour()),
+						Duration:        metav1.Duration{Duration: time.Hour},
+						MachineSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rack": "r1"}},
+					}},
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := svc.InstallMachines(ctx, connect.NewRequest(&machinev1alpha1.InstallMachinesRequest{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rack": "r1"}},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(3))
+			Expect(resp.Msg.Results[0].Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Expect(resp.Msg.Results[2].Name).To(Equal("machine-4"))
+			Expect(resp.Msg.Results[2].Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE))
+			Expect(resp.Msg.Results[2].Reason).To(Equal(MaintenanceWindowFailureReason))
+
+			_, err = svc.Install(ctx, connect.NewRequest(&machinev1alpha1.InstallRequest{Name: "machine-4"}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeFailedPrecondition))
+		})
+
+		It("Should fail if machine group does not exist", func() {
+			_, err := svc.InstallMachines(ctx, connect.NewRequest(&machinev1alpha1.InstallMachinesRequest{
+				MachineGroupRef: &machinev1alpha1.MachineGroupReference{
+					MachineType:  "type-a",
+					MachineGroup: "missing",
+				},
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+
+		It("Should reject machine group reference without machine type", func() {
+			_, err := svc.ScanMachines(ctx, connect.NewRequest(&machinev1alpha1.ScanMachinesRequest{
+				MachineGroupRef: &machinev1alpha1.MachineGroupReference{MachineGroup: "production"},
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+
+		It("Should return package diff without writing in dry run mode", func() {
+			resp, err := svc.SetPackageVersions(ctx, connect.NewRequest(&machinev1alpha1.SetPackageVersionsRequest{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"rack": "r2"}},
+				Packages:      []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+				DryRun:        true,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(1))
+			Expect(resp.Msg.Results[0].Name).To(Equal("machine-3"))
+			Expect(resp.Msg.Results[0].Before).To(BeEmpty())
+			Expect(resp.Msg.Results[0].After).To(HaveLen(1))
+			Expect(resp.Msg.Results[0].After[0].Version).To(Equal("2.0.0"))
+
+			machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, "machine-3", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine.Spec.Packages).To(BeEmpty())
+		})
+
+		It("Should set package versions for machines in machine group", func() {
+			resp, err := svc.SetPackageVersions(ctx, connect.NewRequest(&machinev1alpha1.SetPackageVersionsRequest{
+				MachineGroupRef: &machinev1alpha1.MachineGroupReference{
+					MachineType:  "type-a",
+					MachineGroup: "production",
+				},
+				Packages: []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Results).To(HaveLen(2))
+			for _, result := range resp.Msg.Results {
+				Expect(result.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+				machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, result.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machine.Spec.Packages).To(Equal([]lifecyclev1alpha1.PackageVersion{
+					{Name: "bios", Version: "2.0.0"},
+				}))
+			}
+			machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, "machine-2", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine.Spec.Packages).To(BeEmpty())
+		})
+	})
+
+	Context("Install plan", func() {
+		BeforeEach(func() {
+			clientset = fake.NewSimpleClientset(
+				&lifecyclev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: "metal", Labels: map[string]string{"env": "prod"}},
+					Spec: lifecyclev1alpha1.MachineSpec{
+						MachineTypeRef: corev1.LocalObjectReference{Name: "type-a"},
+						Packages:       []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.2.0"}},
+					},
+					Status: lifecyclev1alpha1.MachineStatus{
+						InstalledPackages: []lifecyclev1alpha1.PackageVersion{
+							{Name: "bios", Version: "1.0.0"},
+							{Name: "bmc", Version: "1.2.0"},
+						},
+					},
+				},
+				&lifecyclev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine-2", Namespace: "metal", Labels: map[string]string{"env": "prod"}},
+					Spec: lifecyclev1alpha1.MachineSpec{
+						MachineTypeRef: corev1.LocalObjectReference{Name: "type-b"},
+					},
+				},
+				&lifecyclev1alpha1.MachineType{
+					ObjectMeta: metav1.ObjectMeta{Name: "type-a", Namespace: "metal"},
+					Spec: lifecyclev1alpha1.MachineTypeSpec{
+						MachineGroups: []lifecyclev1alpha1.MachineGroup{{
+							Name:            "production",
+							MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+							Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+						}},
+					},
+				},
+			)
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"))
+		})
+
+		It("Should return install plan of the machine", func() {
+			resp, err := svc.GetInstallPlan(ctx, connect.NewRequest(&machinev1alpha1.GetInstallPlanRequest{
+				Name: "machine-1",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Plans).To(HaveLen(1))
+			plan := resp.Msg.Plans[0]
+			Expect(plan.Packages).To(HaveLen(2))
+			Expect(plan.Packages[0].Source).To(Equal(machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE))
+			Expect(plan.Packages[0].Pending).To(BeFalse())
+			Expect(plan.Packages[1].Source).To(Equal(machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE_GROUP))
+			Expect(plan.Packages[1].MachineGroup).To(Equal("production"))
+			Expect(plan.Packages[1].InstalledVersion).To(Equal("1.0.0"))
+			Expect(plan.Packages[1].Pending).To(BeTrue())
+			Expect(plan.Installed).To(HaveLen(2))
+			Expect(plan.Pending).To(HaveLen(1))
+			Expect(plan.Pending[0].Version).To(Equal("2.0.0"))
+
+			// plan does not mutate the machine
+			machine, err := clientset.LifecycleV1alpha1().Machines("metal").Get(ctx, "machine-1", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine.Spec.Packages).To(HaveLen(1))
+		})
+
+		It("Should report machines with missing machine type", func() {
+			resp, err := svc.GetInstallPlan(ctx, connect.NewRequest(&machinev1alpha1.GetInstallPlanRequest{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Plans).To(HaveLen(2))
+			Expect(resp.Msg.Plans[0].Reason).To(BeEmpty())
+			Expect(resp.Msg.Plans[1].Name).To(Equal("machine-2"))
+			Expect(resp.Msg.Plans[1].Reason).To(ContainSubstring("not found"))
+		})
+
+		It("Should report missing machine type in namespace which is not cached", func() {
+			// typed client returns empty object along with not found error
+			clientset.PrependReactor("get", "machinetypes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &lifecyclev1alpha1.MachineType{},
+					apierrors.NewNotFound(lifecyclev1alpha1.Resource("machinetypes"), "type-b")
+			})
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"),
+				WithCache(cache.New(clientset, []string{"other"})))
+			resp, err := svc.GetInstallPlan(ctx, connect.NewRequest(&machinev1alpha1.GetInstallPlanRequest{
+				Name: "machine-2",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Plans).To(HaveLen(1))
+			Expect(resp.Msg.Plans[0].Reason).To(ContainSubstring("not found"))
+		})
+
+		It("Should fail if machine does not exist", func() {
+			_, err := svc.GetInstallPlan(ctx, connect.NewRequest(&machinev1alpha1.GetInstallPlanRequest{
+				Name: "missing",
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+	})
+
+	Context("Install approval", func() {
+		pending := []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}
+
+		BeforeEach(func() {
+			machine := &lifecyclev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "sample-machine", Namespace: "metal", ResourceVersion: "1"},
+			}
+			approvalutil.Request(machine, pending, time.Now())
+			clientset = fake.NewSimpleClientset(machine)
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"))
+		})
+
+		It("Should record the decision of the approver", func() {
+			resp, err := svc.ApproveInstall(ctx, connect.NewRequest(&machinev1alpha1.ApproveInstallRequest{
+				Name:     "sample-machine",
+				Revision: approvalutil.Revision(pending),
+				Reject:   true,
+				Reason:   "not tested",
+				Approver: "alice",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Expect(resp.Msg.Approval.State).To(Equal(string(lifecyclev1alpha1.ApprovalStateRejected)))
+			machine := getMachine()
+			Expect(machine.Status.Approval.User).To(Equal("alice"))
+			Expect(machine.Status.Approval.Reason).To(Equal("not tested"))
+			Expect(machine.Status.ApprovalHistory).To(HaveLen(1))
+		})
+
+		It("Should prefer authenticated user over approver of the request", func() {
+			authCtx := interceptor.ContextWithUser(ctx, authenticationv1.UserInfo{Username: "bob"})
+			_, err := svc.ApproveInstall(authCtx, connect.NewRequest(&machinev1alpha1.ApproveInstallRequest{
+				Name:     "sample-machine",
+				Approver: "alice",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getMachine().Status.Approval.User).To(Equal("bob"))
+		})
+
+		It("Should fail if revision does not match pending packages", func() {
+			resp, err := svc.ApproveInstall(ctx, connect.NewRequest(&machinev1alpha1.ApproveInstallRequest{
+				Name:     "sample-machine",
+				Revision: "deadbeef",
+				Approver: "alice",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE))
+			Expect(resp.Msg.Reason).To(ContainSubstring("revision"))
+			Expect(getMachine().Status.Approval.State).To(Equal(lifecyclev1alpha1.ApprovalStatePending))
+		})
+
+		It("Should reject request without approver", func() {
+			_, err := svc.ApproveInstall(ctx, connect.NewRequest(&machinev1alpha1.ApproveInstallRequest{
+				Name: "sample-machine",
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+	})
+
+	Context("Package status", func() {
+		BeforeEach(func() {
+			clientset = fake.NewSimpleClientset(&lifecyclev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "sample-machine", Namespace: "metal", ResourceVersion: "1"},
+				Status: lifecyclev1alpha1.MachineStatus{
+					Message: "installation is in progress",
+					PackageStatuses: []lifecyclev1alpha1.PackageInstallStatus{
+						{Name: "bios", Version: "2.0.0", State: lifecyclev1alpha1.PackageInstallStatePending},
+						{Name: "bmc", Version: "1.1.0", State: lifecyclev1alpha1.PackageInstallStateSucceeded},
+					},
+				},
+			})
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"))
+		})
+
+		It("Should merge package statuses into machine's status", func() {
+			resp, err := svc.UpdatePackageStatus(ctx, connect.NewRequest(&machinev1alpha1.UpdatePackageStatusRequest{
+				Name: "sample-machine",
+				Packages: []*machinev1alpha1.PackageInstallStatus{
+					{Name: "bios", Version: "2.0.0", State: "Failed", Attempts: 1, LastError: "flash failed", JobId: "a"},
+					{Name: "raid", Version: "7.0.0", State: "Pending", JobId: "a"},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			machine := getMachine()
+			Expect(machine.Status.Message).To(Equal("installation is in progress"))
+			Expect(machine.Status.PackageStatuses).To(HaveLen(3))
+			Expect(machine.Status.PackageStatuses[0].State).To(Equal(lifecyclev1alpha1.PackageInstallStateFailed))
+			Expect(machine.Status.PackageStatuses[0].Attempts).To(Equal(int32(1)))
+			Expect(machine.Status.PackageStatuses[0].LastError).To(Equal("flash failed"))
+			Expect(machine.Status.PackageStatuses[1].State).To(Equal(lifecyclev1alpha1.PackageInstallStateSucceeded))
+			Expect(machine.Status.PackageStatuses[2].Name).To(Equal("raid"))
+			Expect(machine.Status.PackageStatuses[2].JobID).To(Equal("a"))
+		})
+
+		It("Should reject unknown state", func() {
+			_, err := svc.UpdatePackageStatus(ctx, connect.NewRequest(&machinev1alpha1.UpdatePackageStatusRequest{
+				Name:     "sample-machine",
+				Packages: []*machinev1alpha1.PackageInstallStatus{{Name: "bios", Version: "2.0.0", State: "Flashing"}},
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeInvalidArgument))
+		})
+
+		It("Should return not found for missing machine", func() {
+			_, err := svc.UpdatePackageStatus(ctx, connect.NewRequest(&machinev1alpha1.UpdatePackageStatusRequest{
+				Name:     "missing",
+				Packages: []*machinev1alpha1.PackageInstallStatus{{Name: "bios", Version: "2.0.0", State: "Pending"}},
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+	})
+
+	Context("Firmware history", func() {
+		installed := []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}, {Name: "bmc", Version: "3.0.0"}}
+		task := scheduler.Task[*lifecyclev1alpha1.Machine]{Key: "a", Type: scheduler.InstallJob, Initiator: "alice"}
+
+		getHistory := func() *lifecyclev1alpha1.MachineHistory {
+			history, err := clientset.LifecycleV1alpha1().MachineHistories("metal").
+				Get(ctx, "sample-machine", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return history
+		}
+
+		It("Should record changed packages only", func() {
+			changes := firmwareChanges(installed, []*commonv1alpha1.PackageVersion{
+				{Name: "bios", Version: "2.0.0"},
+				{Name: "bmc", Version: "3.0.0"},
+				{Name: "raid", Version: "7.0.0"},
+			}, task, time.Now())
+			Expect(changes).To(HaveLen(2))
+			Expect(changes[0].OldVersion).To(Equal("1.0.0"))
+			Expect(changes[0].NewVersion).To(Equal("2.0.0"))
+			Expect(changes[0].Source).To(Equal(lifecyclev1alpha1.FirmwareChangeSourceInstall))
+			Expect(changes[0].JobID).To(Equal("a"))
+			Expect(changes[0].Initiator).To(Equal("alice"))
+			Expect(changes[1].Package).To(Equal("raid"))
+			Expect(changes[1].OldVersion).To(BeEmpty())
+
+			scan := firmwareChanges(installed, []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "1.1.0"}},
+				scheduler.Task[*lifecyclev1alpha1.Machine]{}, time.Now())
+			Expect(scan).To(HaveLen(1))
+			Expect(scan[0].Source).To(Equal(lifecyclev1alpha1.FirmwareChangeSourceScan))
+		})
+
+		It("Should create history owned by the machine and append changes", func() {
+			machine := getMachine()
+			changes := firmwareChanges(installed, []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+				task, time.Now())
+			Expect(svc.recordHistory(ctx, machine, changes)).To(Succeed())
+			history := getHistory()
+			Expect(history.OwnerReferences).To(HaveLen(1))
+			Expect(history.OwnerReferences[0].Kind).To(Equal("Machine"))
+			Expect(history.Changes).To(HaveLen(1))
+
+			// retried report of the same version is not recorded twice
+			Expect(svc.recordHistory(ctx, machine, changes)).To(Succeed())
+			Expect(getHistory().Changes).To(HaveLen(1))
+
+			changes = firmwareChanges(installed, []*commonv1alpha1.PackageVersion{{Name: "bmc", Version: "3.1.0"}},
+				task, time.Now())
+			Expect(svc.recordHistory(ctx, machine, changes)).To(Succeed())
+			Expect(getHistory().Changes).To(HaveLen(2))
+			Expect(getHistory().Changes[1].Package).To(Equal("bmc"))
+		})
+
+		It("Should drop the oldest changes once the limit is exceeded", func() {
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"), WithHistoryLimit(2))
+			machine := getMachine()
+			for _, version := range []string{"2.0.0", "3.0.0", "4.0.0"} {
+				changes := firmwareChanges(installed, []*commonv1alpha1.PackageVersion{{Name: "bios", Version: version}},
+					task, time.Now())
+				Expect(svc.recordHistory(ctx, machine, changes)).To(Succeed())
+			}
+			changes := getHistory().Changes
+			Expect(changes).To(HaveLen(2))
+			Expect(changes[0].NewVersion).To(Equal("3.0.0"))
+			Expect(changes[1].NewVersion).To(Equal("4.0.0"))
+		})
+
+		It("Should return changes filtered by package and limited to the most recent ones", func() {
+			_, err := clientset.LifecycleV1alpha1().MachineHistories("metal").Create(ctx, &lifecyclev1alpha1.MachineHistory{
+				ObjectMeta: metav1.ObjectMeta{Name: "sample-machine", Namespace: "metal"},
+				Changes: []lifecyclev1alpha1.FirmwareChange{
+					{Package: "bios", NewVersion: "1.0.0", Source: lifecyclev1alpha1.FirmwareChangeSourceScan},
+					{Package: "bmc", NewVersion: "3.0.0", Source: lifecyclev1alpha1.FirmwareChangeSourceScan},
+					{Package: "bios", OldVersion: "1.0.0", NewVersion: "2.0.0", Source: lifecyclev1alpha1.FirmwareChangeSourceInstall},
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := svc.GetMachineHistory(ctx, connect.NewRequest(&machinev1alpha1.GetMachineHistoryRequest{
+				Name: "sample-machine",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Changes).To(HaveLen(3))
+
+			resp, err = svc.GetMachineHistory(ctx, connect.NewRequest(&machinev1alpha1.GetMachineHistoryRequest{
+				Name:    "sample-machine",
+				Package: "bios",
+				Limit:   1,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Changes).To(HaveLen(1))
+			Expect(resp.Msg.Changes[0].NewVersion).To(Equal("2.0.0"))
+			Expect(resp.Msg.Changes[0].Source).To(Equal("Install"))
+		})
+
+		It("Should return empty history if no changes were recorded", func() {
+			resp, err := svc.GetMachineHistory(ctx, connect.NewRequest(&machinev1alpha1.GetMachineHistoryRequest{
+				Name: "sample-machine",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Changes).To(BeEmpty())
+		})
+
+		It("Should return not found for missing machine", func() {
+			_, err := svc.GetMachineHistory(ctx, connect.NewRequest(&machinev1alpha1.GetMachineHistoryRequest{
+				Name: "missing",
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+	})
+
+	Context("Firmware drift", func() {
+		It("Should report drifted packages in the condition", func() {
+			drifts := []driftutil.Drift{{
+				FirmwareChange: lifecyclev1alpha1.FirmwareChange{Package: "bios", OldVersion: "2.0.0", NewVersion: "1.0.0"},
+				DesiredVersion: "2.0.0",
+			}}
+			machine := &lifecyclev1alpha1.Machine{}
+			setFirmwareDriftCondition(machine, nil, true, time.Now())
+			Expect(machine.Status.Conditions).To(BeEmpty())
+
+			since := time.Now().Add(-time.Hour).Truncate(time.Second)
+			setFirmwareDriftCondition(machine, drifts, true, since)
+			Expect(machine.Status.Conditions).To(HaveLen(1))
+			Expect(machine.Status.Conditions[0].Type).To(Equal(lifecyclev1alpha1.MachineConditionFirmwareDrift))
+			Expect(machine.Status.Conditions[0].Reason).To(Equal(ReasonOutOfBandChange))
+			Expect(machine.Status.Conditions[0].Message).To(ContainSubstring("package bios: 2.0.0 -> 1.0.0"))
+
+			drifts[0].NewVersion = "1.1.0"
+			setFirmwareDriftCondition(machine, drifts, true, time.Now())
+			Expect(machine.Status.Conditions).To(HaveLen(1))
+			Expect(machine.Status.Conditions[0].Message).To(ContainSubstring("-> 1.1.0"))
+			Expect(machine.Status.Conditions[0].LastTransitionTime.Time).To(Equal(since))
+		})
+
+		It("Should alert on changes of packages, which are not managed, until the next scan", func() {
+			unmanaged := []driftutil.Drift{{
+				FirmwareChange: lifecyclev1alpha1.FirmwareChange{Package: "bmc", OldVersion: "1.1.0", NewVersion: "1.2.0"},
+			}}
+			machine := &lifecyclev1alpha1.Machine{}
+			setFirmwareDriftCondition(machine, unmanaged, true, time.Now())
+			Expect(machine.Status.Conditions).To(HaveLen(1))
+			Expect(machine.Status.Conditions[0].Reason).To(Equal(driftutil.ReasonUnmanagedChange))
+			Expect(machine.Status.Conditions[0].Message).To(Equal("package bmc: 1.1.0 -> 1.2.0 (not managed)"))
+			Expect(driftutil.Drifted(machine)).To(BeFalse())
+
+			// install reports no scan, so the alert is kept
+			setFirmwareDriftCondition(machine, nil, false, time.Now())
+			Expect(machine.Status.Conditions).To(HaveLen(1))
+			setFirmwareDriftCondition(machine, nil, true, time.Now())
+			Expect(machine.Status.Conditions).To(BeEmpty())
+		})
+
+		It("Should keep unresolved drift of desired packages", func() {
+			managed := []driftutil.Drift{{
+				FirmwareChange: lifecyclev1alpha1.FirmwareChange{Package: "bios", OldVersion: "2.0.0", NewVersion: "1.0.0"},
+				DesiredVersion: "2.0.0",
+			}}
+			unmanaged := []driftutil.Drift{{
+				FirmwareChange: lifecyclev1alpha1.FirmwareChange{Package: "bmc", OldVersion: "1.1.0", NewVersion: "1.2.0"},
+			}}
+			machine := &lifecyclev1alpha1.Machine{}
+			setFirmwareDriftCondition(machine, managed, true, time.Now())
+			setFirmwareDriftCondition(machine, unmanaged, true, time.Now())
+			setFirmwareDriftCondition(machine, nil, true, time.Now())
+			Expect(machine.Status.Conditions).To(HaveLen(1))
+			Expect(machine.Status.Conditions[0].Reason).To(Equal(ReasonOutOfBandChange))
+			Expect(machine.Status.Conditions[0].Message).To(ContainSubstring("package bios"))
+			Expect(driftutil.Drifted(machine)).To(BeTrue())
+		})
+	})
+
+	Context("Job status", func() {
+		installFailed := func(status metav1.ConditionStatus) *commonv1alpha1.Condition {
+			return &commonv1alpha1.Condition{
+				Type:               lifecyclev1alpha1.MachineConditionInstallFailed,
+				Status:             string(status),
+				LastTransitionTime: &metav1.Timestamp{Seconds: time.Now().Unix()},
+				Reason:             "InstallationFailed",
+			}
+		}
+
+		BeforeEach(func() {
+			machine := getMachine()
+			machine.Status.Message = "installation in progress"
+			machine.Status.Conditions = []metav1.Condition{{
+				Type:               lifecyclev1alpha1.MachineConditionInstalling,
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "InstallCompleted",
+			}}
+			_, err := clientset.LifecycleV1alpha1().Machines("metal").UpdateStatus(ctx, machine, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			machineScheduler := scheduler.NewScheduler[*lifecyclev1alpha1.Machine](
+				slog.New(slog.NewTextHandler(GinkgoWriter, nil)), &rest.Config{}, "metal",
+				scheduler.WithActiveJobCache[*lifecyclev1alpha1.Machine](1, time.Minute))
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"), WithScheduler(machineScheduler))
+		})
+
+		It("Should merge fields owned by the job and keep the ones owned by the controller", func() {
+			resp, err := svc.UpdateMachineStatus(ctx, connect.NewRequest(&machinev1alpha1.UpdateMachineStatusRequest{
+				Name: "sample-machine",
+				Status: &machinev1alpha1.MachineStatus{
+					LastScanResult:    commonv1alpha1.ScanResult_SCAN_RESULT_SUCCESS,
+					LastScanTime:      &metav1.Timestamp{Seconds: time.Now().Unix()},
+					InstalledPackages: []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+					Message:           "stale message",
+					Conditions: []*commonv1alpha1.Condition{installFailed(metav1.ConditionTrue), {
+						Type:               lifecyclev1alpha1.MachineConditionInstalling,
+						Status:             string(metav1.ConditionTrue),
+						LastTransitionTime: &metav1.Timestamp{Seconds: time.Now().Unix()},
+						Reason:             "InstallRequested",
+					}},
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+
+			machine := getMachine()
+			Expect(machine.Status.LastScanResult).To(Equal(lifecyclev1alpha1.ScanSuccess))
+			Expect(machine.Status.InstalledPackages).To(Equal([]lifecyclev1alpha1.PackageVersion{
+				{Name: "bios", Version: "1.0.0"},
+			}))
+			Expect(machine.Status.Message).To(Equal("installation in progress"))
+			Expect(meta.IsStatusConditionFalse(machine.Status.Conditions,
+				lifecyclev1alpha1.MachineConditionInstalling)).To(BeTrue())
+			// result of the job not tracked anymore is taken
+			Expect(meta.IsStatusConditionTrue(machine.Status.Conditions,
+				lifecyclev1alpha1.MachineConditionInstallFailed)).To(BeTrue())
+		})
+
+		It("Should not take install result from scan job", func() {
+			status := &lifecyclev1alpha1.MachineStatus{}
+			mergeJobStatus(status, &machinev1alpha1.MachineStatus{
+				LastScanResult: commonv1alpha1.ScanResult_SCAN_RESULT_FAILURE,
+				Conditions:     []*commonv1alpha1.Condition{installFailed(metav1.ConditionTrue)},
+			}, scheduler.ScanJob)
+			Expect(status.LastScanResult).To(Equal(lifecyclev1alpha1.ScanFailure))
+			Expect(status.Conditions).To(BeEmpty())
+
+			mergeJobStatus(status, &machinev1alpha1.MachineStatus{
+				LastScanResult: commonv1alpha1.ScanResult_SCAN_RESULT_SUCCESS,
+				Conditions:     []*commonv1alpha1.Condition{installFailed(metav1.ConditionFalse)},
+			}, scheduler.InstallJob)
+			Expect(status.LastScanResult).To(Equal(lifecyclev1alpha1.ScanFailure))
+			Expect(meta.IsStatusConditionFalse(status.Conditions,
+				lifecyclev1alpha1.MachineConditionInstallFailed)).To(BeTrue())
+		})
+
+		It("Should return not found for missing machine", func() {
+			_, err := svc.UpdateMachineStatus(ctx, connect.NewRequest(&machinev1alpha1.UpdateMachineStatusRequest{
+				Name:   "missing",
+				Status: &machinev1alpha1.MachineStatus{},
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeNotFound))
+		})
+	})
+
+	Context("Job events", func() {
+		It("Should report the result of the job", func() {
+			recorder := record.NewFakeRecorder(10)
+			svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"), WithEventRecorder(recorder))
+			machine := getMachine()
+
+			svc.recordJobResult(machine, "1", scheduler.ScanJob, &machinev1alpha1.MachineStatus{
+				LastScanResult: commonv1alpha1.ScanResult_SCAN_RESULT_FAILURE,
+			})
+			svc.recordJobResult(machine, "2", scheduler.InstallJob, &machinev1alpha1.MachineStatus{
+				Conditions: []*commonv1alpha1.Condition{{
+					Type:    lifecyclev1alpha1.MachineConditionInstallFailed,
+					Status:  string(metav1.ConditionTrue),
+					Message: "package bios: timeout",
+				}},
+			})
+			svc.recordJobResult(machine, "3", scheduler.InstallJob, &machinev1alpha1.MachineStatus{})
+
+			Expect(recorder.Events).To(HaveLen(3))
+			Expect(<-recorder.Events).To(Equal("Warning ScanFailed scan job 1 failed"))
+			Expect(<-recorder.Events).To(Equal("Warning InstallFailed install job 2 failed: package bios: timeout"))
+			Expect(<-recorder.Events).To(Equal("Normal InstallCompleted install job 3 completed"))
+		})
+	})
+})
@@ -5,7 +5,9 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -16,14 +18,19 @@ import (
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/applyconfiguration/lifecycle/v1alpha1"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machinetype/v1alpha1/machinetypev1alpha1connect"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/cache"
 	"github.com/ironcore-dev/lifecycle-manager/internal/service/scheduler"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/apiutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/selectorutil"
 	"github.com/ironcore-dev/lifecycle-manager/internal/util/uuidutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/versionutil"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -34,23 +41,35 @@ const (
 
 type MachineTypeService struct {
 	machinetypev1alpha1connect.UnimplementedMachineTypeServiceHandler
-	c         *lifecycle.Clientset
+	c         lifecycle.Interface
 	scheduler *scheduler.Scheduler[*lifecyclev1alpha1.MachineType]
 	horizon   time.Duration
 	namespace string
+	cache     *cache.Cache
+
+	recorder record.EventRecorder
 }
 
 type Option func(service *MachineTypeService)
 
 func NewService(cfg *rest.Config, opts ...Option) *MachineTypeService {
-	c := lifecycle.NewForConfigOrDie(cfg)
-	svc := &MachineTypeService{c: c}
+	svc := &MachineTypeService{}
 	for _, opt := range opts {
 		opt(svc)
 	}
+	if svc.c == nil {
+		svc.c = lifecycle.NewForConfigOrDie(cfg)
+	}
 	return svc
 }
 
+// WithClientset overrides clientset created from rest config.
+func WithClientset(c lifecycle.Interface) Option {
+	return func(svc *MachineTypeService) {
+		svc.c = c
+	}
+}
+
 func WithNamespace(namespace string) Option {
 	return func(svc *MachineTypeService) {
 		svc.namespace = namespace
@@ -63,12 +82,28 @@ func WithHorizon(horizon time.Duration) Option {
 	}
 }
 
+// WithCache makes service read MachineType objects from the informers' cache
+// instead of API server, if target namespace is watched.
+func WithCache(c *cache.Cache) Option {
+	return func(svc *MachineTypeService) {
+		svc.cache = c
+	}
+}
+
 func WithScheduler(scheduler *scheduler.Scheduler[*lifecyclev1alpha1.MachineType]) Option {
 	return func(svc *MachineTypeService) {
 		svc.scheduler = scheduler
 	}
 }
 
+// WithEventRecorder makes service report events of MachineType objects, e.g.
+// results of scans. Events are not reported if recorder is not set.
+func WithEventRecorder(recorder record.EventRecorder) Option {
+	return func(svc *MachineTypeService) {
+		svc.recorder = recorder
+	}
+}
+
 func (s *MachineTypeService) StartScheduler(ctx context.Context) {
 	s.scheduler.Start(ctx)
 }
@@ -85,21 +120,62 @@ func (s *MachineTypeService) ListMachineTypes(
 		namespace = s.namespace
 	}
 
-	opts := metav1.ListOptions{}
-	reqSelector := req.GetLabelSelector()
-	if reqSelector != nil {
-		opts.LabelSelector = labels.Set(reqSelector.MatchLabels).String()
+	labelSelector, err := selectorutil.LabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	machinetypes, err := s.c.LifecycleV1alpha1().MachineTypes(namespace).List(ctx, opts)
+	fieldSelector, err := selectorutil.FieldSelector(
+		req.GetFieldSelector(), selectorutil.MachineTypeFields(&lifecyclev1alpha1.MachineType{}))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// unpaginated list is served from cache, paginated one relies on
+	// continue tokens of API server
+	if lister, ok := s.cache.MachineTypes(namespace); ok && req.GetPageSize() == 0 && req.GetPageToken() == "" {
+		items, err := lister.List(labelSelector)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		slices.SortFunc(items, func(a, b *lifecyclev1alpha1.MachineType) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		resp := &machinetypev1alpha1.ListMachineTypesResponse{}
+		for _, item := range items {
+			if !fieldSelector.Matches(selectorutil.MachineTypeFields(item)) {
+				continue
+			}
+			resp.MachineTypes = append(resp.MachineTypes, apiutil.MachineTypeToGrpcAPI(item.DeepCopy()))
+		}
+		return connect.NewResponse(resp), nil
 	}
-	resp := &machinetypev1alpha1.ListMachineTypesResponse{
-		MachineTypes: make([]*machinetypev1alpha1.MachineType, len(machinetypes.Items))}
-	for i, item := range machinetypes.Items {
-		m := item.DeepCopy()
-		resp.MachineTypes[i] = apiutil.MachineTypeToGrpcAPI(m)
+
+	// objects not matching field selector are filtered out after listing,
+	// so list is repeated until the page is filled or no objects left
+	pageSize := req.GetPageSize()
+	resp := &machinetypev1alpha1.ListMachineTypesResponse{}
+	opts := metav1.ListOptions{LabelSelector: labelSelector.String(), Continue: req.GetPageToken()}
+	for {
+		if pageSize > 0 {
+			opts.Limit = pageSize - int64(len(resp.MachineTypes))
+		}
+		machinetypes, err := s.c.LifecycleV1alpha1().MachineTypes(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, connect.NewError(listErrorCode(err), err)
+		}
+		for i := range machinetypes.Items {
+			item := &machinetypes.Items[i]
+			if !fieldSelector.Matches(selectorutil.MachineTypeFields(item)) {
+				continue
+			}
+			resp.MachineTypes = append(resp.MachineTypes, apiutil.MachineTypeToGrpcAPI(item))
+		}
+		opts.Continue = machinetypes.Continue
+		if opts.Continue == "" || pageSize == 0 || int64(len(resp.MachineTypes)) >= pageSize {
+			break
+		}
 	}
+	resp.NextPageToken = opts.Continue
 	return connect.NewResponse(resp), nil
 }
 
@@ -118,7 +194,7 @@ func (s *MachineTypeService) Scan(
 		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_UNSPECIFIED,
 	}
 
-	machineType, err := s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	machineType, err := s.getMachineType(ctx, namespace, req.Name)
 	if err != nil {
 		errCode := connect.CodeInternal
 		if apierrors.IsNotFound(err) {
@@ -144,7 +220,7 @@ func (s *MachineTypeService) UpdateMachineTypeStatus(
 		namespace = s.namespace
 	}
 
-	machinetype, err := s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	machinetype, err := s.getMachineType(ctx, namespace, req.Name)
 	if err != nil {
 		errCode := connect.CodeInternal
 		if apierrors.IsNotFound(err) {
@@ -152,6 +228,12 @@ func (s *MachineTypeService) UpdateMachineTypeStatus(
 		}
 		return nil, connect.NewError(errCode, err)
 	}
+	// available versions are kept sorted, so the latest version is the last
+	// one regardless of the order packages were discovered in
+	scheme := versionutil.ForManufacturer(machinetype.Spec.Manufacturer)
+	for _, available := range req.GetStatus().GetAvailablePackages() {
+		versionutil.Sort(scheme, available.Versions)
+	}
 	machinetypeApply := v1alpha1.MachineType(machinetype.Name, machinetype.Namespace).
 		WithStatus(apiutil.MachineTypeStatusToApplyConfiguration(req.Status))
 	if _, err = s.c.LifecycleV1alpha1().MachineTypes(namespace).ApplyStatus(ctx, machinetypeApply, metav1.ApplyOptions{
@@ -161,6 +243,7 @@ func (s *MachineTypeService) UpdateMachineTypeStatus(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	key := uuidutil.UUIDFromObjectKey(types.NamespacedName{Name: req.Name, Namespace: namespace})
+	s.recordScanResult(machinetype, key, req.Status)
 	s.scheduler.ForgetFinishedJob(key)
 	return connect.NewResponse(&machinetypev1alpha1.UpdateMachineTypeStatusResponse{
 		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
@@ -179,33 +262,36 @@ func (s *MachineTypeService) AddMachineGroup(
 		namespace = s.namespace
 	}
 
-	machinetype, err := s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, req.Name, metav1.GetOptions{})
-	if err != nil {
-		errCode := connect.CodeInternal
-		if apierrors.IsNotFound(err) {
-			errCode = connect.CodeNotFound
+	for _, pv := range req.GetMachineGroup().GetPackages() {
+		if err := versionutil.Validate(pv.GetVersion()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("package %s: %w", pv.GetName(), err))
 		}
-		return nil, connect.NewError(errCode, err)
 	}
-	machineGroups := apiutil.MachineGroupsToGrpcAPI(machinetype.Spec.MachineGroups)
-	if machineGroupIndex(req.MachineGroup.Name, machineGroups) > -1 {
+	var exists bool
+	machinetype, err := s.updateMachineType(ctx, namespace, req.Name, req.ResourceVersion,
+		func(machinetype *lifecyclev1alpha1.MachineType) bool {
+			machineGroups := apiutil.MachineGroupsToGrpcAPI(machinetype.Spec.MachineGroups)
+			if exists = machineGroupIndex(req.MachineGroup.Name, machineGroups) > -1; exists {
+				return false
+			}
+			machineGroups = slices.Grow(machineGroups, 1)
+			machineGroups = append(machineGroups, req.MachineGroup)
+			machinetype.Spec.MachineGroups = apiutil.MachineGroupsToKubeAPI(machineGroups)
+			return true
+		})
+	if err != nil {
+		return nil, connect.NewError(updateErrorCode(err), err)
+	}
+	if exists {
 		return connect.NewResponse(&machinetypev1alpha1.AddMachineGroupResponse{
-			Reason: AddMachineGroupFailureReason,
-			Result: commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+			Reason:          AddMachineGroupFailureReason,
+			Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE,
+			ResourceVersion: machinetype.ResourceVersion,
 		}), nil
 	}
-	machineGroups = slices.Grow(machineGroups, 1)
-	machineGroups = append(machineGroups, req.MachineGroup)
-	machinetypeApply := v1alpha1.MachineType(machinetype.Name, machinetype.Namespace).WithSpec(
-		v1alpha1.MachineTypeSpec().WithMachineGroups(apiutil.MachineGroupsToApplyConfiguration(machineGroups)...))
-	if _, err = s.c.LifecycleV1alpha1().MachineTypes(namespace).Apply(ctx, machinetypeApply, metav1.ApplyOptions{
-		FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
-		Force:        true,
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
 	return connect.NewResponse(&machinetypev1alpha1.AddMachineGroupResponse{
-		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		ResourceVersion: machinetype.ResourceVersion,
 	}), nil
 }
 
@@ -221,32 +307,22 @@ func (s *MachineTypeService) RemoveMachineGroup(
 		namespace = s.namespace
 	}
 
-	machinetype, err := s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	machinetype, err := s.updateMachineType(ctx, namespace, req.Name, req.ResourceVersion,
+		func(machinetype *lifecyclev1alpha1.MachineType) bool {
+			machineGroups := apiutil.MachineGroupsToGrpcAPI(machinetype.Spec.MachineGroups)
+			idx := machineGroupIndex(req.GroupName, machineGroups)
+			if idx == -1 {
+				return false
+			}
+			machinetype.Spec.MachineGroups = apiutil.MachineGroupsToKubeAPI(removeMachineGroup(machineGroups, idx))
+			return true
+		})
 	if err != nil {
-		errCode := connect.CodeInternal
-		if apierrors.IsNotFound(err) {
-			errCode = connect.CodeNotFound
-		}
-		return nil, connect.NewError(errCode, err)
-	}
-	machineGroups := apiutil.MachineGroupsToGrpcAPI(machinetype.Spec.MachineGroups)
-	var idx int
-	if idx = machineGroupIndex(req.GroupName, machineGroups); idx == -1 {
-		return connect.NewResponse(&machinetypev1alpha1.RemoveMachineGroupResponse{
-			Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
-		}), nil
-	}
-	machineGroups = removeMachineGroup(machineGroups, idx)
-	machinetypeApply := v1alpha1.MachineType(machinetype.Name, machinetype.Namespace).WithSpec(
-		v1alpha1.MachineTypeSpec().WithMachineGroups(apiutil.MachineGroupsToApplyConfiguration(machineGroups)...))
-	if _, err = s.c.LifecycleV1alpha1().MachineTypes(namespace).Apply(ctx, machinetypeApply, metav1.ApplyOptions{
-		FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
-		Force:        true,
-	}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(updateErrorCode(err), err)
 	}
 	return connect.NewResponse(&machinetypev1alpha1.RemoveMachineGroupResponse{
-		Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		Result:          commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS,
+		ResourceVersion: machinetype.ResourceVersion,
 	}), nil
 }
 
@@ -285,3 +361,104 @@ func removeMachineGroup(src []*machinetypev1alpha1.MachineGroup, index int) []*m
 	result = append(result, src[index+1:]...)
 	return result
 }
+
+func listErrorCode(err error) connect.Code {
+	switch {
+	case apierrors.IsResourceExpired(err):
+		return connect.CodeFailedPrecondition
+	case apierrors.IsBadRequest(err):
+		return connect.CodeInvalidArgument
+	}
+	return connect.CodeInternal
+}
+
+func (s *MachineTypeService) getMachineType(ctx context.Context, namespace, name string) (*lifecyclev1alpha1.MachineType, error) {
+	if lister, ok := s.cache.MachineTypes(namespace); ok {
+		obj, err := lister.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return obj.DeepCopy(), nil
+	}
+	return s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// updateMachineType applies mutate to the MachineType and updates it in API
+// server. Non-empty resourceVersion is used as precondition, so conflict is
+// returned to the caller. Otherwise, update is retried on conflict against the
+// latest state of the MachineType. If mutate returns false, update is skipped.
+func (s *MachineTypeService) updateMachineType(
+	ctx context.Context,
+	namespace, name, resourceVersion string,
+	mutate func(*lifecyclev1alpha1.MachineType) bool,
+) (*lifecyclev1alpha1.MachineType, error) {
+	var (
+		result  *lifecyclev1alpha1.MachineType
+		attempt int
+	)
+	update := func() error {
+		var (
+			machinetype *lifecyclev1alpha1.MachineType
+			err         error
+		)
+		// cache might lag behind, so retries and preconditions use the API server
+		if attempt == 0 && resourceVersion == "" {
+			machinetype, err = s.getMachineType(ctx, namespace, name)
+		} else {
+			machinetype, err = s.c.LifecycleV1alpha1().MachineTypes(namespace).Get(ctx, name, metav1.GetOptions{})
+		}
+		attempt++
+		if err != nil {
+			return err
+		}
+		if resourceVersion != "" && machinetype.ResourceVersion != resourceVersion {
+			return apierrors.NewConflict(lifecyclev1alpha1.Resource("machinetypes"), name,
+				fmt.Errorf("resource version %s does not match %s", resourceVersion, machinetype.ResourceVersion))
+		}
+		if !mutate(machinetype) {
+			result = machinetype
+			return nil
+		}
+		result, err = s.c.LifecycleV1alpha1().MachineTypes(namespace).Update(ctx, machinetype, metav1.UpdateOptions{
+			FieldManager: "lifecycle.ironcore.dev/lifecycle-manager",
+		})
+		return err
+	}
+	var err error
+	if resourceVersion != "" {
+		err = update()
+	} else {
+		err = retry.RetryOnConflict(retry.DefaultRetry, update)
+	}
+	return result, err
+}
+
+func updateErrorCode(err error) connect.Code {
+	switch {
+	case apierrors.IsNotFound(err):
+		return connect.CodeNotFound
+	case apierrors.IsConflict(err):
+		return connect.CodeAborted
+	}
+	return connect.CodeInternal
+}
+
+// recordScanResult reports the result of the scan reported by the job with
+// the event of the machine type.
+func (s *MachineTypeService) recordScanResult(
+	machinetype *lifecyclev1alpha1.MachineType,
+	key string,
+	status *machinetypev1alpha1.MachineTypeStatus,
+) {
+	if s.recorder == nil {
+		return
+	}
+	switch status.GetLastScanResult() {
+	case commonv1alpha1.ScanResult_SCAN_RESULT_SUCCESS:
+		s.recorder.Eventf(machinetype, corev1.EventTypeNormal, lifecyclev1alpha1.EventReasonScanCompleted,
+			"scan job %s completed", key)
+	case commonv1alpha1.ScanResult_SCAN_RESULT_FAILURE:
+		s.recorder.Eventf(machinetype, corev1.EventTypeWarning, lifecyclev1alpha1.EventReasonScanFailed,
+			"scan job %s failed", key)
+	}
+}
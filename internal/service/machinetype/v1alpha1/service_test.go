@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"context"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/go-logr/logr"
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	machinetypev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machinetype/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("MachineType Service", func() {
+	var (
+		clientset *fake.Clientset
+		svc       *MachineTypeService
+	)
+	ctx := logr.NewContextWithSlogLogger(context.Background(), slog.New(slog.NewTextHandler(GinkgoWriter, nil)))
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset(&lifecyclev1alpha1.MachineType{
+			ObjectMeta: metav1.ObjectMeta{Name: "sample-machinetype", Namespace: "metal", ResourceVersion: "1"},
+			Spec: lifecyclev1alpha1.MachineTypeSpec{
+				MachineGroups: []lifecyclev1alpha1.MachineGroup{{
+					Name:            "default",
+					MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "1.0.0"}},
+				}},
+			},
+		})
+		svc = NewService(nil, WithClientset(clientset), WithNamespace("metal"))
+	})
+
+	getMachineType := func() *lifecyclev1alpha1.MachineType {
+		machinetype, err := clientset.LifecycleV1alpha1().MachineTypes("metal").
+			Get(ctx, "sample-machinetype", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return machinetype
+	}
+
+	Context("Machine groups", func() {
+		It("Should add and remove machine group", func() {
+			resp, err := svc.AddMachineGroup(ctx, connect.NewRequest(&machinetypev1alpha1.AddMachineGroupRequest{
+				Name: "sample-machinetype",
+				MachineGroup: &machinetypev1alpha1.MachineGroup{
+					Name:            "canary",
+					MachineSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "canary"}},
+					Packages:        []*commonv1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+				},
+				ResourceVersion: "1",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			groups := getMachineType().Spec.MachineGroups
+			Expect(groups).To(HaveLen(2))
+			Expect(groups[1].MachineSelector.MatchLabels).To(Equal(map[string]string{"env": "canary"}))
+			Expect(groups[1].Packages).To(ConsistOf(lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"}))
+
+			_, err = svc.RemoveMachineGroup(ctx, connect.NewRequest(&machinetypev1alpha1.RemoveMachineGroupRequest{
+				Name:      "sample-machinetype",
+				GroupName: "default",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			groups = getMachineType().Spec.MachineGroups
+			Expect(groups).To(HaveLen(1))
+			Expect(groups[0].Name).To(Equal("canary"))
+		})
+
+		It("Should not add machine group twice", func() {
+			resp, err := svc.AddMachineGroup(ctx, connect.NewRequest(&machinetypev1alpha1.AddMachineGroupRequest{
+				Name:         "sample-machinetype",
+				MachineGroup: &machinetypev1alpha1.MachineGroup{Name: "default"},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Msg.Result).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE))
+			Expect(resp.Msg.Reason).To(Equal(AddMachineGroupFailureReason))
+		})
+
+		It("Should abort on resource version mismatch", func() {
+			_, err := svc.RemoveMachineGroup(ctx, connect.NewRequest(&machinetypev1alpha1.RemoveMachineGroupRequest{
+				Name:            "sample-machinetype",
+				GroupName:       "default",
+				ResourceVersion: "0",
+			}))
+			Expect(connect.CodeOf(err)).To(Equal(connect.CodeAborted))
+			Expect(getMachineType().Spec.MachineGroups).To(HaveLen(1))
+		})
+
+		It("Should retry on conflict if precondition is not set", func() {
+			conflicts := 1
+			clientset.PrependReactor("update", "machinetypes",
+				func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if conflicts == 0 {
+						return false, nil, nil
+					}
+					conflicts--
+					return true, nil, apierrors.NewConflict(
+						lifecyclev1alpha1.Resource("machinetypes"), "sample-machinetype", nil)
+				})
+			_, err := svc.RemoveMachineGroup(ctx, connect.NewRequest(&machinetypev1alpha1.RemoveMachineGroupRequest{
+				Name:      "sample-machinetype",
+				GroupName: "default",
+			}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getMachineType().Spec.MachineGroups).To(BeEmpty())
+		})
+	})
+})
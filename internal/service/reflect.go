@@ -3,19 +3,108 @@
 
 package service
 
+import "github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
+
 var Names = []string{
 	"machine.v1alpha1.MachineService.ScanMachine",
 	"machine.v1alpha1.MachineService.Install",
 	"machine.v1alpha1.MachineService.ListMachines",
 	"machine.v1alpha1.MachineService.UpdateMachineStatus",
+	"machine.v1alpha1.MachineService.UpdatePackageStatus",
 	"machine.v1alpha1.MachineService.AddPackageVersion",
 	"machine.v1alpha1.MachineService.SetPackageVersion",
 	"machine.v1alpha1.MachineService.RemovePackageVersion",
 	"machine.v1alpha1.MachineService.GetJob",
+	"machine.v1alpha1.MachineService.ScanMachines",
+	"machine.v1alpha1.MachineService.InstallMachines",
+	"machine.v1alpha1.MachineService.SetPackageVersions",
+	"machine.v1alpha1.MachineService.GetInstallPlan",
+	"machine.v1alpha1.MachineService.ApproveInstall",
+	"machine.v1alpha1.MachineService.GetMachineHistory",
 	"machinetype.v1alpha1.MachineTypeService.Scan",
 	"machinetype.v1alpha1.MachineTypeService.ListMachineTypes",
 	"machinetype.v1alpha1.MachineTypeService.UpdateMachineTypeStatus",
 	"machinetype.v1alpha1.MachineTypeService.AddMachineGroup",
 	"machinetype.v1alpha1.MachineTypeService.RemoveMachineGroup",
 	"machinetype.v1alpha1.MachineTypeService.GetJob",
+	"admin.v1alpha1.AdminService.PauseScheduling",
+	"admin.v1alpha1.AdminService.ResumeScheduling",
+	"admin.v1alpha1.AdminService.GetSchedulingStatus",
+}
+
+// Permissions maps every procedure from Names to the kubernetes verb and
+// resource of lifecycle.ironcore.dev group the caller must be allowed to use.
+var Permissions = map[string]interceptor.ResourceAttributes{
+	"machine.v1alpha1.MachineService.ScanMachine": {
+		Verb: "create", Resource: "machines", Subresource: "scan",
+	},
+	"machine.v1alpha1.MachineService.Install": {
+		Verb: "create", Resource: "machines", Subresource: "install",
+	},
+	"machine.v1alpha1.MachineService.ListMachines": {
+		Verb: "list", Resource: "machines",
+	},
+	"machine.v1alpha1.MachineService.UpdateMachineStatus": {
+		Verb: "update", Resource: "machines", Subresource: "status",
+	},
+	"machine.v1alpha1.MachineService.UpdatePackageStatus": {
+		Verb: "update", Resource: "machines", Subresource: "status",
+	},
+	"machine.v1alpha1.MachineService.AddPackageVersion": {
+		Verb: "update", Resource: "machines",
+	},
+	"machine.v1alpha1.MachineService.SetPackageVersion": {
+		Verb: "update", Resource: "machines",
+	},
+	"machine.v1alpha1.MachineService.RemovePackageVersion": {
+		Verb: "update", Resource: "machines",
+	},
+	"machine.v1alpha1.MachineService.GetJob": {
+		Verb: "get", Resource: "machines", Subresource: "jobs",
+	},
+	"machine.v1alpha1.MachineService.ScanMachines": {
+		Verb: "create", Resource: "machines", Subresource: "scan",
+	},
+	"machine.v1alpha1.MachineService.InstallMachines": {
+		Verb: "create", Resource: "machines", Subresource: "install",
+	},
+	"machine.v1alpha1.MachineService.SetPackageVersions": {
+		Verb: "update", Resource: "machines",
+	},
+	"machine.v1alpha1.MachineService.GetInstallPlan": {
+		Verb: "list", Resource: "machines",
+	},
+	"machine.v1alpha1.MachineService.ApproveInstall": {
+		Verb: "create", Resource: "machines", Subresource: "approve",
+	},
+	"machine.v1alpha1.MachineService.GetMachineHistory": {
+		Verb: "get", Resource: "machinehistories",
+	},
+	"machinetype.v1alpha1.MachineTypeService.Scan": {
+		Verb: "create", Resource: "machinetypes", Subresource: "scan",
+	},
+	"machinetype.v1alpha1.MachineTypeService.ListMachineTypes": {
+		Verb: "list", Resource: "machinetypes",
+	},
+	"machinetype.v1alpha1.MachineTypeService.UpdateMachineTypeStatus": {
+		Verb: "update", Resource: "machinetypes", Subresource: "status",
+	},
+	"machinetype.v1alpha1.MachineTypeService.AddMachineGroup": {
+		Verb: "update", Resource: "machinetypes",
+	},
+	"machinetype.v1alpha1.MachineTypeService.RemoveMachineGroup": {
+		Verb: "update", Resource: "machinetypes",
+	},
+	"machinetype.v1alpha1.MachineTypeService.GetJob": {
+		Verb: "get", Resource: "machinetypes", Subresource: "jobs",
+	},
+	"admin.v1alpha1.AdminService.PauseScheduling": {
+		Verb: "update", Resource: "scheduling",
+	},
+	"admin.v1alpha1.AdminService.ResumeScheduling": {
+		Verb: "update", Resource: "scheduling",
+	},
+	"admin.v1alpha1.AdminService.GetSchedulingStatus": {
+		Verb: "get", Resource: "scheduling",
+	},
 }
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	adminv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/admin/v1alpha1"
+	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
+	machinetypev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machinetype/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var _ = Describe("Procedures", func() {
+	It("Should define permission of every procedure", func() {
+		for _, name := range Names {
+			Expect(Permissions).To(HaveKey(name), name)
+		}
+	})
+
+	It("Should list every procedure of served services", func() {
+		for _, file := range []protoreflect.FileDescriptor{
+			machinev1alpha1.File_machine_v1alpha1_api_proto,
+			machinetypev1alpha1.File_machinetype_v1alpha1_api_proto,
+			adminv1alpha1.File_admin_v1alpha1_api_proto,
+		} {
+			for i := range file.Services().Len() {
+				methods := file.Services().Get(i).Methods()
+				for j := range methods.Len() {
+					Expect(Names).To(ContainElement(string(methods.Get(j).FullName())))
+				}
+			}
+		}
+	})
+})
@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
 	"github.com/jellydator/ttlcache/v3"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -46,8 +50,12 @@ type Scheduler[T LifecycleObject] struct {
 	schedulerWaitGroup sync.WaitGroup
 	mu                 sync.Mutex
 
+	paused atomic.Bool
+
 	namespace  string
 	jobsConfig string
+
+	recorder record.EventRecorder
 }
 
 // NewScheduler creates a new Scheduler instance with the given parameters.
@@ -105,6 +113,14 @@ func WithJobConfig[T LifecycleObject](jobConfig string) Option[T] {
 	}
 }
 
+// WithEventRecorder makes scheduler report accepted and rejected tasks with
+// events of their targets. Events are not reported if recorder is not set.
+func WithEventRecorder[T LifecycleObject](recorder record.EventRecorder) Option[T] {
+	return func(scheduler *Scheduler[T]) {
+		scheduler.recorder = recorder
+	}
+}
+
 func (s *Scheduler[T]) dropFinishedJob(
 	_ context.Context,
 	reason ttlcache.EvictionReason,
@@ -122,6 +138,12 @@ func (s *Scheduler[T]) dropFinishedJob(
 // If the Task is already enqueued in any of the queues, it returns RequestResult_REQUEST_RESULT_SCHEDULED.
 // If the enqueuing in both queues fails, it returns RequestResult_REQUEST_RESULT_FAILURE.
 func (s *Scheduler[T]) Schedule(item Task[T]) commonv1alpha1.RequestResult {
+	result := s.schedule(item)
+	s.recordScheduled(item, result)
+	return result
+}
+
+func (s *Scheduler[T]) schedule(item Task[T]) commonv1alpha1.RequestResult {
 	enqueued := s.activeJobs.Has(item.Key) || s.workqueue.Has(item.Key) || s.pendingTasks.Has(item.Key)
 	if enqueued {
 		return commonv1alpha1.RequestResult_REQUEST_RESULT_SCHEDULED
@@ -142,6 +164,29 @@ func (s *Scheduler[T]) Schedule(item Task[T]) commonv1alpha1.RequestResult {
 	return commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE
 }
 
+// recordScheduled reports the task accepted or rejected by the scheduler with
+// the event of its target. Tasks already enqueued are not reported again.
+func (s *Scheduler[T]) recordScheduled(item Task[T], result commonv1alpha1.RequestResult) {
+	if s.recorder == nil {
+		return
+	}
+	switch result {
+	case commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS:
+		reason := lifecyclev1alpha1.EventReasonScanScheduled
+		if item.Type == InstallJob {
+			reason = lifecyclev1alpha1.EventReasonInstallScheduled
+		}
+		message := fmt.Sprintf("%s job %s scheduled", item.Type, item.Key)
+		if item.Initiator != "" {
+			message += " by " + item.Initiator
+		}
+		s.recorder.Event(item.Target, corev1.EventTypeNormal, reason, message)
+	case commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE:
+		s.recorder.Eventf(item.Target, corev1.EventTypeWarning, lifecyclev1alpha1.EventReasonQueueFull,
+			"%s job rejected: scheduler queue is full", item.Type)
+	}
+}
+
 // ForgetFinishedJob deletes a finished job from the active job tracker.
 // It takes a key string as input and removes the corresponding job from the tracker.
 // If the job is successfully deleted, it is considered forgotten.
@@ -172,6 +217,29 @@ func (s *Scheduler[T]) Start(ctx context.Context) {
 	s.schedulerWaitGroup.Wait()
 }
 
+// Pause stops workers from starting new jobs. Tasks are still accepted and
+// queued, active jobs are not affected.
+func (s *Scheduler[T]) Pause() {
+	if !s.paused.Swap(true) {
+		s.log.Info("scheduling paused")
+	}
+}
+
+// Resume lets workers start jobs for tasks queued while scheduling was paused.
+// Paused workers dropped signals of the tasks, processing of queues triggers
+// free workers once per task in the workqueue.
+func (s *Scheduler[T]) Resume() {
+	if s.paused.Swap(false) {
+		s.log.Info("scheduling resumed")
+		s.triggerQueues()
+	}
+}
+
+// Paused reports whether scheduling is paused.
+func (s *Scheduler[T]) Paused() bool {
+	return s.paused.Load()
+}
+
 func (s *Scheduler[T]) GetActiveJob(id string) (Task[T], error) {
 	item := s.activeJobs.Get(id)
 	if item == nil {
@@ -210,9 +278,10 @@ func (s *Scheduler[T]) schedulingLoop(ctx context.Context) {
 // workerFunc represents the behavior of a worker in the scheduler.
 // It continuously listens for events on the workqueue and performs the necessary actions
 // based on the received events.
-// If an item is enqueued in the workqueue and there is available capacity for new jobs,
-// it dequeues the item from the workqueue, sets it as an active job, and processes the job
+// If an item is enqueued in the workqueue, scheduling is not paused and there is available
+// capacity for new jobs, it dequeues the item from the workqueue, sets it as an active job, and processes the job
 // by calling the processJob function.
+// Tasks which deadline has passed are dropped without starting the job.
 // If there is an error during job processing, it logs the error and removes the active job
 // from the tracker.
 // If the workerFunc receives a cancellation signal from the context, it  decreases the
@@ -221,12 +290,24 @@ func (s *Scheduler[T]) workerFunc(ctx context.Context) {
 	for {
 		select {
 		case <-s.workqueue.Enqueued:
-			if s.activeJobs.Len() == int(s.workers) {
+			// paused worker leaves the task in the workqueue, workers are
+			// triggered again on resume
+			if s.paused.Load() || s.activeJobs.Len() == int(s.workers) {
 				break
 			}
 			s.mu.Lock()
-			task, _ := s.workqueue.Dequeue()
+			task, ok := s.workqueue.Dequeue()
 			s.mu.Unlock()
+			if !ok {
+				break
+			}
+			if task.Expired(time.Now()) {
+				// job must not be started once its maintenance window closed,
+				// next install request schedules it again
+				s.log.Info("task deadline exceeded", "task", task.Key, "deadline", task.Deadline)
+				s.triggerQueues()
+				break
+			}
 			s.activeJobs.Set(task.Key, task, ttlcache.DefaultTTL)
 			if err := s.processJob(ctx, task); err != nil {
 				s.log.Error("failed to process task", "error", err.Error())
@@ -244,12 +325,10 @@ func (s *Scheduler[T]) workerFunc(ctx context.Context) {
 // actions based on the state.
 // If both queues are empty, it logs a message saying there are no pending tasks and returns.
 // If the active jobs tracker is full, it logs a message saying there are no free workers and returns.
-// If the workqueue is full, it triggers as many workers as there are available in the active jobs tracker.
-// It does this by sending a struct{}{} to the s.workqueue.Enqueued channel for each available worker.
-// It then moves as many tasks as possible from the pendingTasks queue to the workqueue.
+// It moves as many tasks as possible from the pendingTasks queue to the workqueue.
 // If the pendingTasks queue is empty, it breaks the loop.
-// Lastly, if the workqueue is not empty and there are available workers, it triggers a worker to process
-// a task from the workqueue.
+// Lastly, it triggers as many workers as there are tasks in the workqueue and available workers in the
+// active jobs tracker. It does this by sending a struct{}{} to the s.workqueue.Enqueued channel for each of them.
 func (s *Scheduler[T]) processQueues() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -268,14 +347,6 @@ func (s *Scheduler[T]) processQueues() {
 		return
 	}
 
-	// assuming there is free capacity in active job tracker
-	// if workqueue is full then trigger as many workers as active job tracker capacity
-	if s.workqueue.IsFull() {
-		for range int(s.workers) - s.activeJobs.Len() {
-			s.workqueue.Enqueued <- struct{}{}
-		}
-	}
-
 	// move as many tasks from pre-processing queue to workqueue as possible
 	for range s.workqueue.FreeCapacity() {
 		if s.pendingTasks.IsEmpty() {
@@ -284,9 +355,30 @@ func (s *Scheduler[T]) processQueues() {
 		s.enqueueTask()
 	}
 
-	// ensure workers will be triggered to pick ip job from working queue
-	if !s.workqueue.IsEmpty() && s.activeJobs.Len() < int(s.workers) {
-		s.workqueue.Enqueued <- struct{}{}
+	// ensure free workers will be triggered to pick up jobs from working queue
+	s.triggerWorkers()
+}
+
+// triggerQueues signals the scheduling loop to process queues, unless the
+// signal is already pending.
+func (s *Scheduler[T]) triggerQueues() {
+	select {
+	case s.done <- struct{}{}:
+	default:
+	}
+}
+
+// triggerWorkers signals as many workers as there are free workers and tasks
+// in the workqueue. Signals which don't fit the channel are dropped, since
+// pending ones trigger workers as well. Sending must not block, since workers
+// receiving signals wait for the lock held by the caller.
+func (s *Scheduler[T]) triggerWorkers() {
+	for range min(int(s.workers)-s.activeJobs.Len(), s.workqueue.Len()) {
+		select {
+		case s.workqueue.Enqueued <- struct{}{}:
+		default:
+			return
+		}
 	}
 }
 
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync/atomic"
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Scheduler", func() {
+	Context("When scheduling is paused", func() {
+		It("Should queue tasks and process them once resumed", func() {
+			s := &Scheduler[*lifecyclev1alpha1.Machine]{log: slog.New(slog.NewTextHandler(io.Discard, nil))}
+			for _, opt := range []Option[*lifecyclev1alpha1.Machine]{
+				WithWorkerCount[*lifecyclev1alpha1.Machine](1),
+				WithActiveJobCache[*lifecyclev1alpha1.Machine](1, time.Minute),
+				WithQueueCapacity[*lifecyclev1alpha1.Machine](1),
+			} {
+				opt(s)
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go s.Start(ctx)
+
+			s.Pause()
+			Expect(s.Paused()).To(BeTrue())
+			// expired task is dropped by the worker without starting the job
+			task := NewTask[*lifecyclev1alpha1.Machine]("1", InstallJob, nil, "machine").
+				WithDeadline(time.Now().Add(-time.Minute))
+			Expect(s.Schedule(task)).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Consistently(func() bool { return s.workqueue.Has(task.Key) }, 100*time.Millisecond).Should(BeTrue())
+
+			s.Resume()
+			Expect(s.Paused()).To(BeFalse())
+			Eventually(func() bool { return s.workqueue.Has(task.Key) }).Should(BeFalse())
+		})
+	})
+
+	Context("When scheduling is resumed", func() {
+		It("Should start queued tasks on all free workers", func() {
+			// existing jobs keep tasks active, so workers are not triggered
+			// by finished jobs
+			var started atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				started.Add(1)
+				job := &batchv1.Job{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+					ObjectMeta: metav1.ObjectMeta{Name: path.Base(r.URL.Path), Namespace: "default"},
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(job)
+			}))
+			defer server.Close()
+			s := NewScheduler[*lifecyclev1alpha1.Machine](
+				slog.New(slog.NewTextHandler(io.Discard, nil)), &rest.Config{Host: server.URL}, "default",
+				WithWorkerCount[*lifecyclev1alpha1.Machine](3),
+				WithActiveJobCache[*lifecyclev1alpha1.Machine](3, time.Minute),
+				WithQueueCapacity[*lifecyclev1alpha1.Machine](1))
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go s.Start(ctx)
+
+			s.Pause()
+			for _, key := range []string{"1", "2"} {
+				task := NewTask[*lifecyclev1alpha1.Machine](key, ScanJob, nil, "machine")
+				Expect(s.Schedule(task)).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			}
+			Consistently(started.Load, 100*time.Millisecond).Should(BeZero())
+
+			s.Resume()
+			Eventually(started.Load).Should(BeEquivalentTo(2))
+			Consistently(started.Load, 100*time.Millisecond).Should(BeEquivalentTo(2))
+			Expect(s.workqueue.IsEmpty()).To(BeTrue())
+		})
+	})
+
+	Context("When event recorder is set", func() {
+		It("Should report accepted and rejected tasks", func() {
+			recorder := record.NewFakeRecorder(10)
+			s := &Scheduler[*lifecyclev1alpha1.Machine]{log: slog.New(slog.NewTextHandler(io.Discard, nil))}
+			for _, opt := range []Option[*lifecyclev1alpha1.Machine]{
+				WithWorkerCount[*lifecyclev1alpha1.Machine](1),
+				WithActiveJobCache[*lifecyclev1alpha1.Machine](1, time.Minute),
+				WithQueueCapacity[*lifecyclev1alpha1.Machine](1),
+				WithEventRecorder[*lifecyclev1alpha1.Machine](recorder),
+			} {
+				opt(s)
+			}
+			newTask := func(key string, jobType JobType) Task[*lifecyclev1alpha1.Machine] {
+				machine := &lifecyclev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: key, Namespace: "default"}}
+				return NewTask(key, jobType, machine, "machine").WithInitiator("alice")
+			}
+
+			Expect(s.Schedule(newTask("1", InstallJob))).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Expect(s.Schedule(newTask("2", ScanJob))).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SUCCESS))
+			Expect(s.Schedule(newTask("3", ScanJob))).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_FAILURE))
+			Expect(s.Schedule(newTask("1", InstallJob))).To(Equal(commonv1alpha1.RequestResult_REQUEST_RESULT_SCHEDULED))
+
+			Expect(recorder.Events).To(HaveLen(3))
+			Expect(<-recorder.Events).To(Equal("Normal InstallScheduled install job 1 scheduled by alice"))
+			Expect(<-recorder.Events).To(Equal("Normal ScanScheduled scan job 2 scheduled by alice"))
+			Expect(<-recorder.Events).To(Equal("Warning QueueFull scan job rejected: scheduler queue is full"))
+		})
+	})
+})
@@ -3,6 +3,8 @@
 
 package scheduler
 
+import "time"
+
 const (
 	ScanJob    JobType = "scan"
 	InstallJob JobType = "install"
@@ -13,6 +15,11 @@ type Task[T LifecycleObject] struct {
 	Type       JobType
 	Target     T
 	TargetType string
+	// Deadline is the time after which the job must not be started, e.g.
+	// because the maintenance window closes. Zero value means no deadline.
+	Deadline time.Time
+	// Initiator is the user who requested the job, empty if unknown.
+	Initiator string
 }
 
 func NewTask[T LifecycleObject](key string, taskType JobType, target T, targetType string) Task[T] {
@@ -23,3 +30,20 @@ func NewTask[T LifecycleObject](key string, taskType JobType, target T, targetTy
 		TargetType: targetType,
 	}
 }
+
+// WithDeadline returns the copy of the task with the given deadline.
+func (t Task[T]) WithDeadline(deadline time.Time) Task[T] {
+	t.Deadline = deadline
+	return t
+}
+
+// Expired reports whether the deadline of the task has passed.
+func (t Task[T]) Expired(now time.Time) bool {
+	return !t.Deadline.IsZero() && now.After(t.Deadline)
+}
+
+// WithInitiator returns the copy of the task requested by the given user.
+func (t Task[T]) WithInitiator(initiator string) Task[T] {
+	t.Initiator = initiator
+	return t
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Task", func() {
+	Context("On deadline", func() {
+		It("Should expire once the deadline has passed", func() {
+			now := time.Now()
+			task := NewTask[*lifecyclev1alpha1.Machine]("1", InstallJob, nil, "machine")
+			Expect(task.Expired(now)).To(BeFalse())
+			task = task.WithDeadline(now.Add(time.Minute))
+			Expect(task.Expired(now)).To(BeFalse())
+			Expect(task.Expired(now.Add(2 * time.Minute))).To(BeTrue())
+		})
+	})
+})
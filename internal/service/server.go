@@ -16,15 +16,26 @@ import (
 	"connectrpc.com/grpcreflect"
 	"connectrpc.com/validate"
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/admin/v1alpha1/adminv1alpha1connect"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machine/v1alpha1/machinev1alpha1connect"
 	"github.com/ironcore-dev/lifecycle-manager/clientgo/connectrpc/machinetype/v1alpha1/machinetypev1alpha1connect"
+	"github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle"
+	lifecyclescheme "github.com/ironcore-dev/lifecycle-manager/clientgo/lifecycle/scheme"
+	adminsvcv1alpha1 "github.com/ironcore-dev/lifecycle-manager/internal/service/admin/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/service/cache"
 	"github.com/ironcore-dev/lifecycle-manager/internal/service/interceptor"
 	machinesvcv1alpha1 "github.com/ironcore-dev/lifecycle-manager/internal/service/machine/v1alpha1"
 	machinetypesvcv1alpha1 "github.com/ironcore-dev/lifecycle-manager/internal/service/machinetype/v1alpha1"
 	"github.com/ironcore-dev/lifecycle-manager/internal/service/scheduler"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 )
 
 type GrpcServer struct {
@@ -33,6 +44,9 @@ type GrpcServer struct {
 	port               int
 	machineService     *machinesvcv1alpha1.MachineService
 	machineTypeService *machinetypesvcv1alpha1.MachineTypeService
+	adminService       *adminsvcv1alpha1.AdminService
+	authorizer         *interceptor.AuthorizationInterceptor
+	cache              *cache.Cache
 }
 
 type Options struct {
@@ -41,11 +55,20 @@ type Options struct {
 	Host string
 	Port int
 
-	Namespace     string
-	JobsConfig    string
-	Workers       uint64
-	Horizon       time.Duration
-	QueueCapacity uint64
+	Namespace       string
+	WatchNamespaces []string
+	JobsConfig      string
+	Workers         uint64
+	Horizon         time.Duration
+	QueueCapacity   uint64
+	HistoryLimit    int
+
+	// SchedulingStateConfigMap is the name of the ConfigMap persisting the
+	// scheduling status, it is kept in memory only if empty.
+	SchedulingStateConfigMap string
+
+	Authorization    bool
+	AuthorizationTTL time.Duration
 }
 
 func NewGrpcServer(opts Options) *GrpcServer {
@@ -54,8 +77,16 @@ func NewGrpcServer(opts Options) *GrpcServer {
 		host: opts.Host,
 		port: opts.Port,
 	}
-	srv.machineService = setupMachineService(opts)
-	srv.machineTypeService = setupMachineTypeService(opts)
+	srv.cache = setupCache(opts)
+	recorder := setupEventRecorder(opts)
+	machineScheduler := setupScheduler[*lifecyclev1alpha1.Machine](opts, "Machine", recorder)
+	machineTypeScheduler := setupScheduler[*lifecyclev1alpha1.MachineType](opts, "MachineType", recorder)
+	srv.machineService = setupMachineService(opts, srv.cache, machineScheduler, recorder)
+	srv.machineTypeService = setupMachineTypeService(opts, srv.cache, machineTypeScheduler, recorder)
+	srv.adminService = setupAdminService(opts, machineScheduler, machineTypeScheduler)
+	if opts.Authorization {
+		srv.authorizer = setupAuthorizer(opts)
+	}
 	return srv
 }
 
@@ -70,12 +101,23 @@ func (s *GrpcServer) Start(ctx context.Context) error {
 		return err
 	}
 	logger := interceptor.NewLoggerInterceptor(s.log)
+	interceptors := []connect.Interceptor{logger}
+	if s.authorizer != nil {
+		interceptors = append(interceptors, s.authorizer)
+		go s.authorizer.Start(ctx)
+	}
+	interceptors = append(interceptors, validator)
 
 	// enable services
 	mux.Handle(machinev1alpha1connect.NewMachineServiceHandler(s.machineService,
-		connect.WithInterceptors(logger, validator)))
+		connect.WithInterceptors(interceptors...)))
 	mux.Handle(machinetypev1alpha1connect.NewMachineTypeServiceHandler(s.machineTypeService,
-		connect.WithInterceptors(logger, validator)))
+		connect.WithInterceptors(interceptors...)))
+	mux.Handle(adminv1alpha1connect.NewAdminServiceHandler(s.adminService,
+		connect.WithInterceptors(interceptors...)))
+
+	// enable metrics
+	mux.Handle("/metrics", promhttp.Handler())
 
 	// enable health checks
 	mux.Handle(grpchealth.NewHandler(checker))
@@ -95,6 +137,18 @@ func (s *GrpcServer) Start(ctx context.Context) error {
 		<-ctx.Done()
 	}()
 
+	s.cache.Start(ctx)
+	if err = s.cache.WaitForCacheSync(ctx); err != nil {
+		s.log.Error("failed to sync cache", "error", err.Error())
+		return err
+	}
+
+	// paused scheduling must be restored before schedulers start jobs
+	if err = s.adminService.Restore(ctx); err != nil {
+		s.log.Error("failed to restore scheduling state", "error", err.Error())
+		return err
+	}
+
 	go s.machineService.StartScheduler(ctx)
 	go s.machineTypeService.StartScheduler(ctx)
 
@@ -102,30 +156,85 @@ func (s *GrpcServer) Start(ctx context.Context) error {
 	return http.ListenAndServe(fmt.Sprintf("%s:%d", s.host, s.port), h2c.NewHandler(mux, srv))
 }
 
-func setupMachineService(opts Options) *machinesvcv1alpha1.MachineService {
-	machineScheduler := scheduler.NewScheduler[*lifecyclev1alpha1.Machine](
-		opts.Log.With("scheduler", "Machine"), opts.Cfg, opts.Namespace,
-		scheduler.WithWorkerCount[*lifecyclev1alpha1.Machine](opts.Workers),
-		scheduler.WithActiveJobCache[*lifecyclev1alpha1.Machine](opts.Workers, opts.Horizon),
-		scheduler.WithQueueCapacity[*lifecyclev1alpha1.Machine](opts.QueueCapacity),
-		scheduler.WithJobConfig[*lifecyclev1alpha1.Machine](opts.JobsConfig))
+func setupCache(opts Options) *cache.Cache {
+	namespaces := make([]string, 0, len(opts.WatchNamespaces))
+	for _, namespace := range opts.WatchNamespaces {
+		if namespace == "*" {
+			namespace = metav1.NamespaceAll
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	if len(namespaces) == 0 {
+		namespaces = append(namespaces, opts.Namespace)
+	}
+	return cache.New(lifecycle.NewForConfigOrDie(opts.Cfg), namespaces)
+}
+
+func setupScheduler[T scheduler.LifecycleObject](
+	opts Options,
+	kind string,
+	recorder record.EventRecorder,
+) *scheduler.Scheduler[T] {
+	return scheduler.NewScheduler[T](
+		opts.Log.With("scheduler", kind), opts.Cfg, opts.Namespace,
+		scheduler.WithWorkerCount[T](opts.Workers),
+		scheduler.WithActiveJobCache[T](opts.Workers, opts.Horizon),
+		scheduler.WithQueueCapacity[T](opts.QueueCapacity),
+		scheduler.WithJobConfig[T](opts.JobsConfig),
+		scheduler.WithEventRecorder[T](recorder))
+}
+
+func setupMachineService(
+	opts Options,
+	c *cache.Cache,
+	machineScheduler *scheduler.Scheduler[*lifecyclev1alpha1.Machine],
+	recorder record.EventRecorder,
+) *machinesvcv1alpha1.MachineService {
 	machineService := machinesvcv1alpha1.NewService(opts.Cfg,
 		machinesvcv1alpha1.WithNamespace(opts.Namespace),
 		machinesvcv1alpha1.WithHorizon(opts.Horizon),
-		machinesvcv1alpha1.WithScheduler(machineScheduler))
+		machinesvcv1alpha1.WithHistoryLimit(opts.HistoryLimit),
+		machinesvcv1alpha1.WithCache(c),
+		machinesvcv1alpha1.WithScheduler(machineScheduler),
+		machinesvcv1alpha1.WithEventRecorder(recorder))
 	return machineService
 }
 
-func setupMachineTypeService(opts Options) *machinetypesvcv1alpha1.MachineTypeService {
-	machinetypeScheduler := scheduler.NewScheduler[*lifecyclev1alpha1.MachineType](
-		opts.Log.With("scheduler", "MachineType"), opts.Cfg, opts.Namespace,
-		scheduler.WithWorkerCount[*lifecyclev1alpha1.MachineType](opts.Workers),
-		scheduler.WithActiveJobCache[*lifecyclev1alpha1.MachineType](opts.Workers, opts.Horizon),
-		scheduler.WithQueueCapacity[*lifecyclev1alpha1.MachineType](opts.QueueCapacity),
-		scheduler.WithJobConfig[*lifecyclev1alpha1.MachineType](opts.JobsConfig))
+func setupMachineTypeService(
+	opts Options,
+	c *cache.Cache,
+	machinetypeScheduler *scheduler.Scheduler[*lifecyclev1alpha1.MachineType],
+	recorder record.EventRecorder,
+) *machinetypesvcv1alpha1.MachineTypeService {
 	machinetypeService := machinetypesvcv1alpha1.NewService(opts.Cfg,
 		machinetypesvcv1alpha1.WithNamespace(opts.Namespace),
 		machinetypesvcv1alpha1.WithHorizon(opts.Horizon),
-		machinetypesvcv1alpha1.WithScheduler(machinetypeScheduler))
+		machinetypesvcv1alpha1.WithCache(c),
+		machinetypesvcv1alpha1.WithScheduler(machinetypeScheduler),
+		machinetypesvcv1alpha1.WithEventRecorder(recorder))
 	return machinetypeService
 }
+
+func setupAdminService(opts Options, schedulers ...adminsvcv1alpha1.Scheduler) *adminsvcv1alpha1.AdminService {
+	adminOpts := []adminsvcv1alpha1.Option{adminsvcv1alpha1.WithSchedulers(schedulers...)}
+	if opts.SchedulingStateConfigMap != "" {
+		configMaps := kubernetes.NewForConfigOrDie(opts.Cfg).CoreV1().ConfigMaps(opts.Namespace)
+		adminOpts = append(adminOpts,
+			adminsvcv1alpha1.WithStateConfigMap(configMaps, opts.SchedulingStateConfigMap))
+	}
+	return adminsvcv1alpha1.NewService(adminOpts...)
+}
+
+func setupEventRecorder(opts Options) record.EventRecorder {
+	clientset := kubernetes.NewForConfigOrDie(opts.Cfg)
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+	return broadcaster.NewRecorder(lifecyclescheme.Scheme, corev1.EventSource{Component: "lifecycle-service"})
+}
+
+func setupAuthorizer(opts Options) *interceptor.AuthorizationInterceptor {
+	clientset := kubernetes.NewForConfigOrDie(opts.Cfg)
+	return interceptor.NewAuthorizationInterceptor(
+		opts.Log.With("interceptor", "Authorization"), clientset, opts.Namespace, Permissions,
+		interceptor.WithDecisionTTL(opts.AuthorizationTTL))
+}
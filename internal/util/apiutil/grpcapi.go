@@ -4,9 +4,11 @@
 package apiutil
 
 import (
+	"slices"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
 	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
@@ -22,6 +24,44 @@ var LCIMScanResultToString = map[commonv1alpha1.ScanResult]lifecyclev1alpha1.Sca
 	commonv1alpha1.ScanResult_SCAN_RESULT_FAILURE:     lifecyclev1alpha1.ScanFailure,
 }
 
+// DowngradePolicyToKubeAPI converts downgrade policy, unspecified policy is
+// converted to the empty one, which means the policy is inherited.
+func DowngradePolicyToKubeAPI(src machinetypev1alpha1.DowngradePolicy) lifecyclev1alpha1.DowngradePolicy {
+	switch src {
+	case machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_DENY:
+		return lifecyclev1alpha1.DowngradePolicyDeny
+	case machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_ALLOW_WITH_ANNOTATION:
+		return lifecyclev1alpha1.DowngradePolicyAllowWithAnnotation
+	case machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_ALLOW:
+		return lifecyclev1alpha1.DowngradePolicyAllow
+	}
+	return ""
+}
+
+// ApprovalModeToKubeAPI converts approval mode, unspecified mode is converted
+// to the empty one, which means no approval is required.
+func ApprovalModeToKubeAPI(src machinetypev1alpha1.ApprovalMode) lifecyclev1alpha1.ApprovalMode {
+	switch src {
+	case machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_NONE:
+		return lifecyclev1alpha1.ApprovalModeNone
+	case machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_REQUIRED:
+		return lifecyclev1alpha1.ApprovalModeRequired
+	}
+	return ""
+}
+
+// DriftPolicyToKubeAPI converts drift policy, unspecified policy is converted
+// to the empty one, which means drifted machines are re-converged.
+func DriftPolicyToKubeAPI(src machinetypev1alpha1.DriftPolicy) lifecyclev1alpha1.DriftPolicy {
+	switch src {
+	case machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_RECONVERGE:
+		return lifecyclev1alpha1.DriftPolicyReconverge
+	case machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_ALERT:
+		return lifecyclev1alpha1.DriftPolicyAlert
+	}
+	return ""
+}
+
 func MachineToKubeAPI(src *machinev1alpha1.Machine) *lifecyclev1alpha1.Machine {
 	return nil
 }
@@ -71,6 +111,21 @@ func ConditionsToApplyConfiguration(
 	return result
 }
 
+func ConditionToKubeAPI(src *commonv1alpha1.Condition) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               src.Type,
+		Status:             metav1.ConditionStatus(src.Status),
+		ObservedGeneration: src.ObservedGeneration,
+		Reason:             src.Reason,
+		Message:            src.Message,
+	}
+	if src.LastTransitionTime != nil {
+		condition.LastTransitionTime = metav1.Time{
+			Time: time.Unix(src.LastTransitionTime.Seconds, int64(src.LastTransitionTime.Nanos))}
+	}
+	return condition
+}
+
 func PackageVersionsToApplyConfiguration(
 	src []*commonv1alpha1.PackageVersion,
 ) []*lifecycleapplyv1alpha1.PackageVersionApplyConfiguration {
@@ -98,6 +153,35 @@ func PackageVersionsToKubeAPI(src []*commonv1alpha1.PackageVersion) []lifecyclev
 	return result
 }
 
+func PackageInstallStatusesToKubeAPI(
+	src []*machinev1alpha1.PackageInstallStatus,
+) []lifecyclev1alpha1.PackageInstallStatus {
+	result := make([]lifecyclev1alpha1.PackageInstallStatus, len(src))
+	for i, item := range src {
+		el := lifecyclev1alpha1.PackageInstallStatus{
+			Name:      item.Name,
+			Version:   item.Version,
+			State:     lifecyclev1alpha1.PackageInstallState(item.State),
+			Attempts:  item.Attempts,
+			LastError: item.LastError,
+			JobID:     item.JobId,
+		}
+		if item.StartTime != nil {
+			el.StartTime = &metav1.Time{Time: time.Unix(item.StartTime.Seconds, int64(item.StartTime.Nanos))}
+		}
+		if item.CompletionTime != nil {
+			el.CompletionTime = &metav1.Time{
+				Time: time.Unix(item.CompletionTime.Seconds, int64(item.CompletionTime.Nanos))}
+		}
+		if item.LastTransitionTime != nil {
+			el.LastTransitionTime = metav1.Time{
+				Time: time.Unix(item.LastTransitionTime.Seconds, int64(item.LastTransitionTime.Nanos))}
+		}
+		result[i] = el
+	}
+	return result
+}
+
 func MachineTypeToKubeAPI(src *machinetypev1alpha1.MachineType) *lifecyclev1alpha1.MachineType {
 	return nil
 }
@@ -137,6 +221,11 @@ func AvailablePackagesToApplyConfiguration(
 		result[i] = lifecycleapplyv1alpha1.AvailablePackageVersions().
 			WithName(item.Name).
 			WithVersions(item.Versions...)
+		for _, dependency := range item.Dependencies {
+			result[i].WithDependencies(lifecycleapplyv1alpha1.PackageDependency().
+				WithName(dependency.Name).
+				WithVersion(dependency.Version))
+		}
 	}
 	return result
 }
@@ -150,14 +239,28 @@ func AvailablePackageVersionsToKubeAPI(
 	result := make([]lifecyclev1alpha1.AvailablePackageVersions, len(src))
 	for i, item := range src {
 		el := lifecyclev1alpha1.AvailablePackageVersions{
-			Name:     item.Name,
-			Versions: item.Versions,
+			Name:         item.Name,
+			Versions:     item.Versions,
+			Dependencies: PackageDependenciesToKubeAPI(item.Dependencies),
 		}
 		result[i] = el
 	}
 	return result
 }
 
+func PackageDependenciesToKubeAPI(
+	src []*machinetypev1alpha1.PackageDependency,
+) []lifecyclev1alpha1.PackageDependency {
+	if len(src) == 0 {
+		return nil
+	}
+	result := make([]lifecyclev1alpha1.PackageDependency, len(src))
+	for i, item := range src {
+		result[i] = lifecyclev1alpha1.PackageDependency{Name: item.Name, Version: item.Version}
+	}
+	return result
+}
+
 func MachineGroupsToApplyConfiguration(
 	src []*machinetypev1alpha1.MachineGroup,
 ) []*lifecycleapplyv1alpha1.MachineGroupApplyConfiguration {
@@ -166,11 +269,84 @@ func MachineGroupsToApplyConfiguration(
 		result[i] = lifecycleapplyv1alpha1.MachineGroup().
 			WithName(item.Name).
 			WithPackages(PackageVersionsToApplyConfiguration(item.Packages)...).
+			WithPriority(item.Priority).
 			WithMachineSelector(LabelSelectorToApplyConfiguration(item.MachineSelector))
+		// unspecified policy is inherited from the machine type
+		if policy := DowngradePolicyToKubeAPI(item.DowngradePolicy); policy != "" {
+			result[i] = result[i].WithDowngradePolicy(policy)
+		}
+		if item.Rollout != nil {
+			result[i] = result[i].WithRollout(RolloutStrategyToApplyConfiguration(item.Rollout))
+		}
+		if len(item.MaintenanceWindows) > 0 {
+			result[i] = result[i].WithMaintenanceWindows(item.MaintenanceWindows...)
+		}
+		if approval := ApprovalModeToKubeAPI(item.Approval); approval != "" {
+			result[i] = result[i].WithApproval(approval)
+		}
+		if policy := DriftPolicyToKubeAPI(item.DriftPolicy); policy != "" {
+			result[i] = result[i].WithDriftPolicy(policy)
+		}
+	}
+	return result
+}
+
+func MachineGroupsToKubeAPI(src []*machinetypev1alpha1.MachineGroup) []lifecyclev1alpha1.MachineGroup {
+	if src == nil {
+		return []lifecyclev1alpha1.MachineGroup{}
+	}
+	result := make([]lifecyclev1alpha1.MachineGroup, len(src))
+	for i, item := range src {
+		el := lifecyclev1alpha1.MachineGroup{
+			Name:               item.Name,
+			Packages:           PackageVersionsToKubeAPI(item.Packages),
+			Priority:           item.Priority,
+			DowngradePolicy:    DowngradePolicyToKubeAPI(item.DowngradePolicy),
+			Rollout:            RolloutStrategyToKubeAPI(item.Rollout),
+			MaintenanceWindows: slices.Clone(item.MaintenanceWindows),
+			Approval:           ApprovalModeToKubeAPI(item.Approval),
+			DriftPolicy:        DriftPolicyToKubeAPI(item.DriftPolicy),
+		}
+		if item.MachineSelector != nil {
+			el.MachineSelector = *item.MachineSelector.DeepCopy()
+		}
+		result[i] = el
 	}
 	return result
 }
 
+func RolloutStrategyToKubeAPI(src *machinetypev1alpha1.RolloutStrategy) *lifecyclev1alpha1.RolloutStrategy {
+	if src == nil {
+		return nil
+	}
+	s := &lifecyclev1alpha1.RolloutStrategy{
+		CanaryBatchSize:      src.CanaryBatchSize,
+		MaxFailurePercentage: src.MaxFailurePercentage,
+	}
+	if src.MaxUnavailable != "" {
+		maxUnavailable := intstr.Parse(src.MaxUnavailable)
+		s.MaxUnavailable = &maxUnavailable
+	}
+	if src.PauseBetweenBatches != nil {
+		s.PauseBetweenBatches = metav1.Duration{Duration: src.PauseBetweenBatches.Duration}
+	}
+	return s
+}
+
+func RolloutStrategyToApplyConfiguration(
+	src *machinetypev1alpha1.RolloutStrategy,
+) *lifecycleapplyv1alpha1.RolloutStrategyApplyConfiguration {
+	strategy := RolloutStrategyToKubeAPI(src)
+	apply := lifecycleapplyv1alpha1.RolloutStrategy().
+		WithCanaryBatchSize(strategy.CanaryBatchSize).
+		WithPauseBetweenBatches(strategy.PauseBetweenBatches).
+		WithMaxFailurePercentage(strategy.MaxFailurePercentage)
+	if strategy.MaxUnavailable != nil {
+		apply = apply.WithMaxUnavailable(*strategy.MaxUnavailable)
+	}
+	return apply
+}
+
 func LabelSelectorToApplyConfiguration(
 	src *metav1.LabelSelector,
 ) *v1.LabelSelectorApplyConfiguration {
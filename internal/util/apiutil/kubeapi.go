@@ -10,6 +10,8 @@ import (
 	commonv1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/common/v1alpha1"
 	machinev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machine/v1alpha1"
 	machinetypev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/proto/machinetype/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/convertutil"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -20,6 +22,43 @@ var ScanResultToInt = map[lifecyclev1alpha1.ScanResult]commonv1alpha1.ScanResult
 	lifecyclev1alpha1.ScanFailure: commonv1alpha1.ScanResult_SCAN_RESULT_FAILURE,
 }
 
+var PackageSourceToInt = map[lifecyclev1alpha1.PackageSource]machinev1alpha1.PackageSource{
+	lifecyclev1alpha1.PackageSourceMachine:      machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE,
+	lifecyclev1alpha1.PackageSourceMachineGroup: machinev1alpha1.PackageSource_PACKAGE_SOURCE_MACHINE_GROUP,
+}
+
+func DowngradePolicyToGrpcAPI(src lifecyclev1alpha1.DowngradePolicy) machinetypev1alpha1.DowngradePolicy {
+	switch src {
+	case lifecyclev1alpha1.DowngradePolicyDeny:
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_DENY
+	case lifecyclev1alpha1.DowngradePolicyAllowWithAnnotation:
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_ALLOW_WITH_ANNOTATION
+	case lifecyclev1alpha1.DowngradePolicyAllow:
+		return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_ALLOW
+	}
+	return machinetypev1alpha1.DowngradePolicy_DOWNGRADE_POLICY_UNSPECIFIED
+}
+
+func ApprovalModeToGrpcAPI(src lifecyclev1alpha1.ApprovalMode) machinetypev1alpha1.ApprovalMode {
+	switch src {
+	case lifecyclev1alpha1.ApprovalModeNone:
+		return machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_NONE
+	case lifecyclev1alpha1.ApprovalModeRequired:
+		return machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_REQUIRED
+	}
+	return machinetypev1alpha1.ApprovalMode_APPROVAL_MODE_UNSPECIFIED
+}
+
+func DriftPolicyToGrpcAPI(src lifecyclev1alpha1.DriftPolicy) machinetypev1alpha1.DriftPolicy {
+	switch src {
+	case lifecyclev1alpha1.DriftPolicyReconverge:
+		return machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_RECONVERGE
+	case lifecyclev1alpha1.DriftPolicyAlert:
+		return machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_ALERT
+	}
+	return machinetypev1alpha1.DriftPolicy_DRIFT_POLICY_UNSPECIFIED
+}
+
 func MachineToGrpcAPI(src *lifecyclev1alpha1.Machine) *machinev1alpha1.Machine {
 	m := &machinev1alpha1.Machine{
 		TypeMeta:   &src.TypeMeta,
@@ -47,10 +86,99 @@ func MachineStatusToGrpcAPI(src lifecyclev1alpha1.MachineStatus) *machinev1alpha
 		InstalledPackages: PackageVersionsToGrpcAPI(src.InstalledPackages),
 		Message:           src.Message,
 		Conditions:        ConditionsToGrpcAPI(src.Conditions),
+		DesiredPackages:   DesiredPackageVersionsToGrpcAPI(src.DesiredPackages),
+		Approval:          InstallApprovalToGrpcAPI(src.Approval),
+		ApprovalHistory:   InstallApprovalsToGrpcAPI(src.ApprovalHistory),
+		PackageStatuses:   PackageInstallStatusesToGrpcAPI(src.PackageStatuses),
 	}
 	return s
 }
 
+func PackageInstallStatusesToGrpcAPI(
+	src []lifecyclev1alpha1.PackageInstallStatus,
+) []*machinev1alpha1.PackageInstallStatus {
+	result := make([]*machinev1alpha1.PackageInstallStatus, len(src))
+	for i, item := range src {
+		el := &machinev1alpha1.PackageInstallStatus{
+			Name:               item.Name,
+			Version:            item.Version,
+			State:              string(item.State),
+			Attempts:           item.Attempts,
+			LastError:          item.LastError,
+			JobId:              item.JobID,
+			LastTransitionTime: &metav1.Timestamp{Seconds: item.LastTransitionTime.Unix()},
+		}
+		if item.StartTime != nil {
+			el.StartTime = &metav1.Timestamp{Seconds: item.StartTime.Unix()}
+		}
+		if item.CompletionTime != nil {
+			el.CompletionTime = &metav1.Timestamp{Seconds: item.CompletionTime.Unix()}
+		}
+		result[i] = el
+	}
+	return result
+}
+
+func FirmwareChangesToGrpcAPI(src []lifecyclev1alpha1.FirmwareChange) []*machinev1alpha1.FirmwareChange {
+	result := make([]*machinev1alpha1.FirmwareChange, len(src))
+	for i, item := range src {
+		el := &machinev1alpha1.FirmwareChange{
+			Time:       &metav1.Timestamp{Seconds: item.Time.Unix()},
+			Package:    item.Package,
+			OldVersion: item.OldVersion,
+			NewVersion: item.NewVersion,
+			Source:     string(item.Source),
+			JobId:      item.JobID,
+			Initiator:  item.Initiator,
+		}
+		result[i] = el
+	}
+	return result
+}
+
+func InstallApprovalToGrpcAPI(src *lifecyclev1alpha1.InstallApproval) *machinev1alpha1.InstallApproval {
+	if src == nil {
+		return nil
+	}
+	el := &machinev1alpha1.InstallApproval{
+		Revision:    src.Revision,
+		Packages:    PackageVersionsToGrpcAPI(src.Packages),
+		State:       string(src.State),
+		RequestTime: &metav1.Timestamp{Seconds: src.RequestTime.Unix()},
+		User:        src.User,
+		Reason:      src.Reason,
+	}
+	if src.DecisionTime != nil {
+		el.DecisionTime = &metav1.Timestamp{Seconds: src.DecisionTime.Unix()}
+	}
+	return el
+}
+
+func InstallApprovalsToGrpcAPI(src []lifecyclev1alpha1.InstallApproval) []*machinev1alpha1.InstallApproval {
+	result := make([]*machinev1alpha1.InstallApproval, len(src))
+	for i := range src {
+		result[i] = InstallApprovalToGrpcAPI(&src[i])
+	}
+	return result
+}
+
+func DesiredPackageVersionsToGrpcAPI(
+	src []lifecyclev1alpha1.DesiredPackageVersion,
+) []*machinev1alpha1.DesiredPackageVersion {
+	result := make([]*machinev1alpha1.DesiredPackageVersion, len(src))
+	for i, item := range src {
+		el := &machinev1alpha1.DesiredPackageVersion{
+			Name:         item.Name,
+			Version:      item.Version,
+			Source:       PackageSourceToInt[item.Source],
+			MachineGroup: item.MachineGroup,
+			Constraint:   item.Constraint,
+		}
+		result[i] = el
+	}
+	return result
+}
+
 func PackageVersionsToGrpcAPI(src []lifecyclev1alpha1.PackageVersion) []*commonv1alpha1.PackageVersion {
 	result := make([]*commonv1alpha1.PackageVersion, len(src))
 	for i, item := range src {
@@ -91,10 +219,12 @@ func MachineTypeToGrpcAPI(src *lifecyclev1alpha1.MachineType) *machinetypev1alph
 
 func MachineTypeSpecToGrpcAPI(src lifecyclev1alpha1.MachineTypeSpec) *machinetypev1alpha1.MachineTypeSpec {
 	s := &machinetypev1alpha1.MachineTypeSpec{
-		Manufacturer:  src.Manufacturer,
-		Type:          src.Type,
-		ScanPeriod:    &src.ScanPeriod,
-		MachineGroups: MachineGroupsToGrpcAPI(src.MachineGroups),
+		Manufacturer:       src.Manufacturer,
+		Type:               src.Type,
+		ScanPeriod:         &src.ScanPeriod,
+		MachineGroups:      MachineGroupsToGrpcAPI(src.MachineGroups),
+		DowngradePolicy:    DowngradePolicyToGrpcAPI(src.DowngradePolicy),
+		MaintenanceWindows: MaintenanceWindowsToGrpcAPI(src.MaintenanceWindows),
 	}
 	return s
 }
@@ -103,35 +233,127 @@ func MachineGroupsToGrpcAPI(src []lifecyclev1alpha1.MachineGroup) []*machinetype
 	result := make([]*machinetypev1alpha1.MachineGroup, len(src))
 	for i, item := range src {
 		el := &machinetypev1alpha1.MachineGroup{
+			Name:               item.Name,
+			MachineSelector:    item.MachineSelector.DeepCopy(),
+			Packages:           PackageVersionsToGrpcAPI(item.Packages),
+			Priority:           item.Priority,
+			DowngradePolicy:    DowngradePolicyToGrpcAPI(item.DowngradePolicy),
+			Rollout:            RolloutStrategyToGrpcAPI(item.Rollout),
+			MaintenanceWindows: slices.Clone(item.MaintenanceWindows),
+			Approval:           ApprovalModeToGrpcAPI(item.Approval),
+			DriftPolicy:        DriftPolicyToGrpcAPI(item.DriftPolicy),
+		}
+		result[i] = el
+	}
+	return result
+}
+
+func MaintenanceWindowsToGrpcAPI(
+	src []lifecyclev1alpha1.MaintenanceWindow,
+) []*machinetypev1alpha1.MaintenanceWindow {
+	result := make([]*machinetypev1alpha1.MaintenanceWindow, len(src))
+	for i, item := range src {
+		result[i] = &machinetypev1alpha1.MaintenanceWindow{
 			Name:            item.Name,
+			Schedule:        item.Schedule,
+			Duration:        &metav1.Duration{Duration: item.Duration.Duration},
+			TimeZone:        item.TimeZone,
 			MachineSelector: item.MachineSelector.DeepCopy(),
-			Packages:        PackageVersionsToGrpcAPI(item.Packages),
 		}
-		result[i] = el
 	}
 	return result
 }
 
+func RolloutStrategyToGrpcAPI(src *lifecyclev1alpha1.RolloutStrategy) *machinetypev1alpha1.RolloutStrategy {
+	if src == nil {
+		return nil
+	}
+	s := &machinetypev1alpha1.RolloutStrategy{
+		CanaryBatchSize:      src.CanaryBatchSize,
+		PauseBetweenBatches:  &metav1.Duration{Duration: src.PauseBetweenBatches.Duration},
+		MaxFailurePercentage: src.MaxFailurePercentage,
+	}
+	if src.MaxUnavailable != nil {
+		s.MaxUnavailable = src.MaxUnavailable.String()
+	}
+	return s
+}
+
 func MachineTypeStatusToGrpcAPI(src lifecyclev1alpha1.MachineTypeStatus) *machinetypev1alpha1.MachineTypeStatus {
 	s := &machinetypev1alpha1.MachineTypeStatus{
 		LastScanTime:      &metav1.Timestamp{Seconds: src.LastScanTime.Unix()},
 		LastScanResult:    ScanResultToInt[src.LastScanResult],
 		AvailablePackages: AvailablePackagesToGrpcAPI(src.AvailablePackages),
 		Message:           src.Message,
+		Rollouts:          RolloutsToGrpcAPI(src.Rollouts),
+		Conditions:        ConditionsToGrpcAPI(src.Conditions),
 	}
 	return s
 }
 
+func RolloutsToGrpcAPI(
+	src []lifecyclev1alpha1.MachineGroupRolloutStatus,
+) []*machinetypev1alpha1.MachineGroupRolloutStatus {
+	result := make([]*machinetypev1alpha1.MachineGroupRolloutStatus, len(src))
+	for i, item := range src {
+		result[i] = &machinetypev1alpha1.MachineGroupRolloutStatus{
+			Name:               item.Name,
+			Revision:           item.Revision,
+			Phase:              string(item.Phase),
+			Batch:              item.Batch,
+			Machines:           item.Machines,
+			Updated:            item.Updated,
+			Installing:         item.Installing,
+			Failed:             item.Failed,
+			LastTransitionTime: convertutil.TimeToTimestampPtr(item.LastTransitionTime),
+			Message:            item.Message,
+		}
+	}
+	return result
+}
+
 func AvailablePackagesToGrpcAPI(
 	src []lifecyclev1alpha1.AvailablePackageVersions,
 ) []*machinetypev1alpha1.AvailablePackageVersions {
 	result := make([]*machinetypev1alpha1.AvailablePackageVersions, len(src))
 	for i, item := range src {
 		el := &machinetypev1alpha1.AvailablePackageVersions{
-			Name:     item.Name,
-			Versions: slices.Clone(item.Versions),
+			Name:         item.Name,
+			Versions:     slices.Clone(item.Versions),
+			Dependencies: PackageDependenciesToGrpcAPI(item.Dependencies),
 		}
 		result[i] = el
 	}
 	return result
 }
+
+func PackageDependenciesToGrpcAPI(
+	src []lifecyclev1alpha1.PackageDependency,
+) []*machinetypev1alpha1.PackageDependency {
+	if len(src) == 0 {
+		return nil
+	}
+	result := make([]*machinetypev1alpha1.PackageDependency, len(src))
+	for i, item := range src {
+		result[i] = &machinetypev1alpha1.PackageDependency{Name: item.Name, Version: item.Version}
+	}
+	return result
+}
+
+func DesiredPackagesToGrpcAPI(src []planutil.DesiredPackage) []*machinev1alpha1.PlannedPackage {
+	result := make([]*machinev1alpha1.PlannedPackage, len(src))
+	for i, item := range src {
+		result[i] = &machinev1alpha1.PlannedPackage{
+			Name:             item.Name,
+			Version:          item.Version,
+			Source:           PackageSourceToInt[item.Source],
+			MachineGroup:     item.MachineGroup,
+			InstalledVersion: item.InstalledVersion,
+			Pending:          item.Pending(),
+			Constraint:       item.Constraint,
+			Downgrade:        item.Downgrade,
+			Blocked:          item.Blocked,
+		}
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approvalutil
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HistoryLimit is the maximum number of decisions kept in approval history.
+const HistoryLimit = 10
+
+var (
+	// ErrNoPendingApproval means the machine has no installation to decide on.
+	ErrNoPendingApproval = errors.New("no installation awaits approval")
+	// ErrRevisionMismatch means the decision refers to packages other than
+	// the pending ones.
+	ErrRevisionMismatch = errors.New("approval revision does not match pending packages")
+	// ErrAlreadyDecided means the pending installation is already approved
+	// or rejected.
+	ErrAlreadyDecided = errors.New("installation is already decided")
+)
+
+// Required reports whether installation of packages on machines of the
+// group must be approved.
+func Required(group *lifecyclev1alpha1.MachineGroup) bool {
+	return group != nil && group.Approval == lifecyclev1alpha1.ApprovalModeRequired
+}
+
+// Revision returns the revision identifying pending packages. Revision
+// changes whenever any pending package or its version changes.
+func Revision(pending []lifecyclev1alpha1.PackageVersion) string {
+	sorted := slices.Clone(pending)
+	slices.SortFunc(sorted, func(a, b lifecyclev1alpha1.PackageVersion) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	h := fnv.New32a()
+	for _, pv := range sorted {
+		_, _ = fmt.Fprintf(h, "%s=%s\n", pv.Name, pv.Version)
+	}
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// Request ensures the approval of pending packages is requested. Approval of
+// the same revision is kept as is, otherwise the new pending approval
+// replaces the existing one.
+func Request(machine *lifecyclev1alpha1.Machine, pending []lifecyclev1alpha1.PackageVersion, now time.Time) {
+	revision := Revision(pending)
+	if machine.Status.Approval != nil && machine.Status.Approval.Revision == revision {
+		return
+	}
+	machine.Status.Approval = &lifecyclev1alpha1.InstallApproval{
+		Revision:    revision,
+		Packages:    slices.Clone(pending),
+		State:       lifecyclev1alpha1.ApprovalStatePending,
+		RequestTime: metav1.NewTime(now),
+	}
+}
+
+// Decide records the decision of the user on the pending approval of the
+// machine and appends it to approval history. Empty revision refers to the
+// pending approval, whatever its revision is.
+func Decide(
+	machine *lifecyclev1alpha1.Machine,
+	state lifecyclev1alpha1.ApprovalState,
+	user, reason, revision string,
+	now time.Time,
+) error {
+	approval := machine.Status.Approval
+	switch {
+	case approval == nil:
+		return ErrNoPendingApproval
+	case revision != "" && revision != approval.Revision:
+		return fmt.Errorf("%w: %s", ErrRevisionMismatch, approval.Revision)
+	case approval.State != lifecyclev1alpha1.ApprovalStatePending:
+		return fmt.Errorf("%w: %s by %s", ErrAlreadyDecided, strings.ToLower(string(approval.State)), approval.User)
+	}
+	decisionTime := metav1.NewTime(now)
+	approval.State = state
+	approval.User = user
+	approval.Reason = reason
+	approval.DecisionTime = &decisionTime
+	machine.Status.ApprovalHistory = append(machine.Status.ApprovalHistory, *approval.DeepCopy())
+	if extra := len(machine.Status.ApprovalHistory) - HistoryLimit; extra > 0 {
+		machine.Status.ApprovalHistory = machine.Status.ApprovalHistory[extra:]
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package approvalutil
+
+import (
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Approval", func() {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	pending := []lifecyclev1alpha1.PackageVersion{
+		{Name: "raid", Version: "1.2.0"},
+		{Name: "bios", Version: "2.0.0"},
+	}
+
+	It("Should not depend on the order of pending packages", func() {
+		reversed := []lifecyclev1alpha1.PackageVersion{pending[1], pending[0]}
+		Expect(Revision(reversed)).To(Equal(Revision(pending)))
+		changed := []lifecyclev1alpha1.PackageVersion{pending[0], {Name: "bios", Version: "2.1.0"}}
+		Expect(Revision(changed)).NotTo(Equal(Revision(pending)))
+	})
+
+	It("Should keep the request of the same revision", func() {
+		machine := &lifecyclev1alpha1.Machine{}
+		Request(machine, pending, now)
+		Expect(machine.Status.Approval.State).To(Equal(lifecyclev1alpha1.ApprovalStatePending))
+		Expect(machine.Status.Approval.Packages).To(Equal(pending))
+		Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateApproved, "alice", "", "", now)).To(Succeed())
+
+		Request(machine, pending, now.Add(time.Hour))
+		Expect(machine.Status.Approval.State).To(Equal(lifecyclev1alpha1.ApprovalStateApproved))
+
+		Request(machine, pending[:1], now.Add(time.Hour))
+		Expect(machine.Status.Approval.State).To(Equal(lifecyclev1alpha1.ApprovalStatePending))
+		Expect(machine.Status.Approval.Revision).To(Equal(Revision(pending[:1])))
+	})
+
+	It("Should record the decision in history", func() {
+		machine := &lifecyclev1alpha1.Machine{}
+		Request(machine, pending, now)
+		revision := machine.Status.Approval.Revision
+		Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateRejected, "bob", "not tested", revision, now)).
+			To(Succeed())
+		Expect(machine.Status.Approval.User).To(Equal("bob"))
+		Expect(machine.Status.Approval.DecisionTime.Time).To(Equal(now))
+		Expect(machine.Status.ApprovalHistory).To(HaveLen(1))
+		Expect(machine.Status.ApprovalHistory[0]).To(Equal(*machine.Status.Approval))
+	})
+
+	It("Should fail to decide on missing, outdated or decided approval", func() {
+		machine := &lifecyclev1alpha1.Machine{}
+		Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateApproved, "alice", "", "", now)).
+			To(MatchError(ErrNoPendingApproval))
+		Request(machine, pending, now)
+		Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateApproved, "alice", "", "deadbeef", now)).
+			To(MatchError(ErrRevisionMismatch))
+		Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateApproved, "alice", "", "", now)).To(Succeed())
+		Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateRejected, "bob", "", "", now)).
+			To(MatchError(ErrAlreadyDecided))
+	})
+
+	It("Should limit approval history", func() {
+		machine := &lifecyclev1alpha1.Machine{}
+		for i := range HistoryLimit + 2 {
+			Request(machine, []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: string(rune('a' + i))}}, now)
+			Expect(Decide(machine, lifecyclev1alpha1.ApprovalStateApproved, "alice", "", "", now)).To(Succeed())
+		}
+		Expect(machine.Status.ApprovalHistory).To(HaveLen(HistoryLimit))
+		Expect(machine.Status.ApprovalHistory[HistoryLimit-1]).To(Equal(*machine.Status.Approval))
+	})
+})
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependencyutil
+
+import (
+	"fmt"
+	"strings"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/versionutil"
+)
+
+// UnsatisfiedError reports the dependency, which is neither installed nor
+// pending in the required version.
+type UnsatisfiedError struct {
+	Package    string
+	Dependency lifecyclev1alpha1.PackageDependency
+	// Version is the version of the dependency the package would be
+	// installed with, empty if the dependency is not installed.
+	Version string
+}
+
+func (e *UnsatisfiedError) Error() string {
+	required := e.Dependency.Name
+	if e.Dependency.Version != "" {
+		required += " " + e.Dependency.Version
+	}
+	if e.Version == "" {
+		return fmt.Sprintf("package %s requires %s, which is not installed", e.Package, required)
+	}
+	return fmt.Sprintf("package %s requires %s, got version %s", e.Package, required, e.Version)
+}
+
+// CycleError reports packages depending on each other.
+type CycleError struct {
+	Packages []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("dependency cycle between packages %s", strings.Join(e.Packages, ", "))
+}
+
+// Dependencies returns dependencies of available packages by package name.
+func Dependencies(
+	available []lifecyclev1alpha1.AvailablePackageVersions,
+) map[string][]lifecyclev1alpha1.PackageDependency {
+	result := make(map[string][]lifecyclev1alpha1.PackageDependency, len(available))
+	for _, item := range available {
+		if len(item.Dependencies) > 0 {
+			result[item.Name] = append(result[item.Name], item.Dependencies...)
+		}
+	}
+	return result
+}
+
+// Order returns pending packages in the order of installation, so every
+// package is installed after pending packages it depends on. Packages
+// independent of each other keep their original order. Dependencies, which
+// are not pending, must be installed already. Version of the dependency must
+// satisfy the required one, which is either exact version or constraint
+// expression compared according to the versioning scheme.
+func Order(
+	scheme versionutil.Scheme,
+	pending []lifecyclev1alpha1.PackageVersion,
+	installed []lifecyclev1alpha1.PackageVersion,
+	dependencies map[string][]lifecyclev1alpha1.PackageDependency,
+) ([]lifecyclev1alpha1.PackageVersion, error) {
+	pendingVersions := versions(pending)
+	installedVersions := versions(installed)
+	// number of pending dependencies of every pending package
+	blockers := make(map[string]int, len(pending))
+	for _, pv := range pending {
+		for _, dependency := range dependencies[pv.Name] {
+			version, isPending := pendingVersions[dependency.Name]
+			if !isPending {
+				version = installedVersions[dependency.Name]
+			}
+			if err := check(scheme, pv.Name, dependency, version); err != nil {
+				return nil, err
+			}
+			if isPending {
+				blockers[pv.Name]++
+			}
+		}
+	}
+
+	result := make([]lifecyclev1alpha1.PackageVersion, 0, len(pending))
+	ordered := make(map[string]bool, len(pending))
+	for len(result) < len(pending) {
+		idx := -1
+		for i, pv := range pending {
+			if !ordered[pv.Name] && blockers[pv.Name] == 0 {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, &CycleError{Packages: unordered(pending, ordered)}
+		}
+		next := pending[idx]
+		result = append(result, next)
+		ordered[next.Name] = true
+		for _, pv := range pending {
+			for _, dependency := range dependencies[pv.Name] {
+				if dependency.Name == next.Name {
+					blockers[pv.Name]--
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func check(
+	scheme versionutil.Scheme,
+	name string,
+	dependency lifecyclev1alpha1.PackageDependency,
+	version string,
+) error {
+	err := &UnsatisfiedError{Package: name, Dependency: dependency, Version: version}
+	switch {
+	case version == "":
+		return err
+	case dependency.Version == "":
+		return nil
+	case !versionutil.IsConstraint(dependency.Version):
+		if dependency.Version != version {
+			return err
+		}
+		return nil
+	}
+	constraint, parseErr := versionutil.ParseConstraint(dependency.Version)
+	if parseErr != nil {
+		return fmt.Errorf("package %s: %w", name, parseErr)
+	}
+	if !constraint.Check(scheme, version) {
+		return err
+	}
+	return nil
+}
+
+func versions(packages []lifecyclev1alpha1.PackageVersion) map[string]string {
+	result := make(map[string]string, len(packages))
+	for _, pv := range packages {
+		result[pv.Name] = pv.Version
+	}
+	return result
+}
+
+func unordered(pending []lifecyclev1alpha1.PackageVersion, ordered map[string]bool) []string {
+	var result []string
+	for _, pv := range pending {
+		if !ordered[pv.Name] {
+			result = append(result, pv.Name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package dependencyutil
+
+import (
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/versionutil"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Install order", func() {
+	scheme := versionutil.ForManufacturer("")
+	available := []lifecyclev1alpha1.AvailablePackageVersions{
+		{Name: "bios", Versions: []string{"2.0.0"}, Dependencies: []lifecyclev1alpha1.PackageDependency{
+			{Name: "bmc", Version: ">=1.5.0"},
+		}},
+		{Name: "driver", Versions: []string{"3.0.0"}, Dependencies: []lifecyclev1alpha1.PackageDependency{
+			{Name: "nic", Version: "4.1.0"},
+		}},
+	}
+
+	It("Should install dependencies first and keep order of independent packages", func() {
+		pending := []lifecyclev1alpha1.PackageVersion{
+			{Name: "driver", Version: "3.0.0"},
+			{Name: "bios", Version: "2.0.0"},
+			{Name: "raid", Version: "7.0.0"},
+			{Name: "nic", Version: "4.1.0"},
+			{Name: "bmc", Version: "1.6.0"},
+		}
+		ordered, err := Order(scheme, pending, nil, Dependencies(available))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ordered).To(Equal([]lifecyclev1alpha1.PackageVersion{
+			{Name: "raid", Version: "7.0.0"},
+			{Name: "nic", Version: "4.1.0"},
+			{Name: "driver", Version: "3.0.0"},
+			{Name: "bmc", Version: "1.6.0"},
+			{Name: "bios", Version: "2.0.0"},
+		}))
+	})
+
+	It("Should accept dependencies installed already", func() {
+		pending := []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}
+		installed := []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.5.0"}}
+		ordered, err := Order(scheme, pending, installed, Dependencies(available))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ordered).To(Equal(pending))
+	})
+
+	It("Should report unsatisfied dependencies", func() {
+		pending := []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}}
+		_, err := Order(scheme, pending, nil, Dependencies(available))
+		Expect(err).To(MatchError("package bios requires bmc >=1.5.0, which is not installed"))
+
+		installed := []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.1.0"}}
+		_, err = Order(scheme, pending, installed, Dependencies(available))
+		var unsatisfied *UnsatisfiedError
+		Expect(err).To(BeAssignableToTypeOf(unsatisfied))
+		Expect(err).To(MatchError("package bios requires bmc >=1.5.0, got version 1.1.0"))
+
+		pending = []lifecyclev1alpha1.PackageVersion{{Name: "driver", Version: "3.0.0"}, {Name: "nic", Version: "4.0.0"}}
+		_, err = Order(scheme, pending, nil, Dependencies(available))
+		Expect(err).To(MatchError("package driver requires nic 4.1.0, got version 4.0.0"))
+	})
+
+	It("Should report dependency cycles", func() {
+		dependencies := map[string][]lifecyclev1alpha1.PackageDependency{
+			"bios": {{Name: "bmc"}},
+			"bmc":  {{Name: "bios"}},
+		}
+		pending := []lifecyclev1alpha1.PackageVersion{
+			{Name: "raid", Version: "7.0.0"},
+			{Name: "bios", Version: "2.0.0"},
+			{Name: "bmc", Version: "1.6.0"},
+		}
+		_, err := Order(scheme, pending, nil, dependencies)
+		Expect(err).To(MatchError("dependency cycle between packages bios, bmc"))
+	})
+})
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package driftutil
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReasonUnmanagedChange is the reason of FirmwareDrift condition, which
+// reports only changes of packages without desired version. Such drift is
+// an alert only, since there is no version to re-converge to.
+const ReasonUnmanagedChange = "UnmanagedPackageChanged"
+
+// Drift is the change of installed package version, which was not made by
+// installation of the desired version.
+type Drift struct {
+	lifecyclev1alpha1.FirmwareChange
+	// DesiredVersion is the effective desired version of the package, empty
+	// if the package is not managed.
+	DesiredVersion string
+}
+
+// Managed reports whether the drifted package has desired version.
+func (d Drift) Managed() bool {
+	return d.DesiredVersion != ""
+}
+
+// Detect returns changes found by the scan, which move installed versions of
+// desired packages away from desired ones, or change versions of packages
+// without desired entry. Packages found for the first time and packages,
+// which constraints are not resolved, are not considered drifted.
+func Detect(
+	desired []lifecyclev1alpha1.DesiredPackageVersion,
+	changes []lifecyclev1alpha1.FirmwareChange,
+) []Drift {
+	var result []Drift
+	for _, change := range changes {
+		if change.Source != lifecyclev1alpha1.FirmwareChangeSourceScan || change.OldVersion == "" {
+			continue
+		}
+		idx := slices.IndexFunc(desired, func(item lifecyclev1alpha1.DesiredPackageVersion) bool {
+			return item.Name == change.Package
+		})
+		if idx < 0 {
+			result = append(result, Drift{FirmwareChange: change})
+			continue
+		}
+		if desired[idx].Version == "" || desired[idx].Version == change.NewVersion {
+			continue
+		}
+		result = append(result, Drift{FirmwareChange: change, DesiredVersion: desired[idx].Version})
+	}
+	return result
+}
+
+// Message lists drifted packages with their previous, current and desired
+// versions.
+func Message(drifts []Drift) string {
+	items := make([]string, len(drifts))
+	for i, drift := range drifts {
+		desired := "not managed"
+		if drift.Managed() {
+			desired = "desired " + drift.DesiredVersion
+		}
+		items[i] = fmt.Sprintf("package %s: %s -> %s (%s)",
+			drift.Package, drift.OldVersion, orNone(drift.NewVersion), desired)
+	}
+	return strings.Join(items, "; ")
+}
+
+// Reconverge reports whether drifted machines of the group are installed the
+// desired versions again. Machines without group are always re-converged.
+func Reconverge(group *lifecyclev1alpha1.MachineGroup) bool {
+	return group == nil || group.DriftPolicy != lifecyclev1alpha1.DriftPolicyAlert
+}
+
+// Drifted reports whether the drift of desired packages of the machine is
+// not resolved yet. Changes of packages, which are not managed, are not
+// considered, since they are never resolved by installation.
+func Drifted(machine *lifecyclev1alpha1.Machine) bool {
+	condition := meta.FindStatusCondition(machine.Status.Conditions, lifecyclev1alpha1.MachineConditionFirmwareDrift)
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason != ReasonUnmanagedChange
+}
+
+func orNone(version string) string {
+	if version == "" {
+		return "<none>"
+	}
+	return version
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package driftutil
+
+import (
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Drift", func() {
+	desired := []lifecyclev1alpha1.DesiredPackageVersion{
+		{Name: "bios", Version: "2.0.0"},
+		{Name: "bmc", Version: "3.0.0"},
+		{Name: "raid", Constraint: ">=7.0.0"},
+	}
+	scanned := func(name, oldVersion, newVersion string) lifecyclev1alpha1.FirmwareChange {
+		return lifecyclev1alpha1.FirmwareChange{
+			Package:    name,
+			OldVersion: oldVersion,
+			NewVersion: newVersion,
+			Source:     lifecyclev1alpha1.FirmwareChangeSourceScan,
+		}
+	}
+
+	It("Should detect changes of desired packages found by the scan", func() {
+		drifts := Detect(desired, []lifecyclev1alpha1.FirmwareChange{
+			scanned("bios", "2.0.0", "1.9.0"),
+			scanned("bmc", "2.9.0", "3.0.0"),
+			scanned("nic", "1.0.0", "1.1.0"),
+			scanned("raid", "7.0.0", "6.0.0"),
+		})
+		Expect(drifts).To(HaveLen(2))
+		Expect(drifts[0].Package).To(Equal("bios"))
+		Expect(drifts[0].DesiredVersion).To(Equal("2.0.0"))
+		Expect(drifts[0].Managed()).To(BeTrue())
+		Expect(drifts[1].Package).To(Equal("nic"))
+		Expect(drifts[1].Managed()).To(BeFalse())
+		Expect(Message(drifts)).To(Equal(
+			"package bios: 2.0.0 -> 1.9.0 (desired 2.0.0); package nic: 1.0.0 -> 1.1.0 (not managed)"))
+	})
+
+	It("Should not consider changes of packages, which are not managed, as unresolved drift", func() {
+		machine := &lifecyclev1alpha1.Machine{}
+		machine.Status.Conditions = []metav1.Condition{{
+			Type:   lifecyclev1alpha1.MachineConditionFirmwareDrift,
+			Status: metav1.ConditionTrue,
+			Reason: ReasonUnmanagedChange,
+		}}
+		Expect(Drifted(machine)).To(BeFalse())
+		machine.Status.Conditions[0].Reason = "OutOfBandChange"
+		Expect(Drifted(machine)).To(BeTrue())
+	})
+
+	It("Should ignore installed and newly found packages", func() {
+		install := scanned("bios", "1.0.0", "1.9.0")
+		install.Source = lifecyclev1alpha1.FirmwareChangeSourceInstall
+		Expect(Detect(desired, []lifecyclev1alpha1.FirmwareChange{install, scanned("bmc", "", "2.0.0")})).To(BeEmpty())
+	})
+
+	It("Should re-converge machines unless group only alerts", func() {
+		Expect(Reconverge(nil)).To(BeTrue())
+		Expect(Reconverge(&lifecyclev1alpha1.MachineGroup{})).To(BeTrue())
+		Expect(Reconverge(&lifecyclev1alpha1.MachineGroup{DriftPolicy: lifecyclev1alpha1.DriftPolicyAlert})).To(BeFalse())
+	})
+})
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package planutil
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/versionutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// DesiredPackage is the effective desired version of the package.
+type DesiredPackage struct {
+	lifecyclev1alpha1.PackageVersion
+
+	// Source defines where the version comes from.
+	Source lifecyclev1alpha1.PackageSource
+	// MachineGroup is the name of machine group defining the version.
+	MachineGroup string
+	// Constraint is the constraint expression the version is resolved from.
+	Constraint string
+	// InstalledVersion is the version currently installed on the machine.
+	InstalledVersion string
+	// Unresolved is the reason the constraint could not be resolved to the
+	// concrete version.
+	Unresolved string
+	// Downgrade reports whether the version is lower than the installed one
+	// according to the versioning scheme of the manufacturer.
+	Downgrade bool
+	// Blocked reports whether the downgrade is denied by downgrade policy.
+	Blocked bool
+}
+
+// Pending reports whether the desired version is not installed yet and
+// installation is not blocked.
+func (p DesiredPackage) Pending() bool {
+	return p.Version != p.InstalledVersion && !p.Blocked
+}
+
+// Plan is the install plan of the machine.
+type Plan struct {
+	// Group is the machine group of the machine, nil if no group matches.
+	Group *lifecyclev1alpha1.MachineGroup
+	// Desired contains packages defined in machine's spec followed by
+	// packages defined by machine group, which are not overridden by spec.
+	Desired []DesiredPackage
+	// Pending contains packages which versions differ from installed ones.
+	Pending []lifecyclev1alpha1.PackageVersion
+	// Unresolved contains packages which version constraints match none of
+	// available versions. Such packages are not installed.
+	Unresolved []DesiredPackage
+	// Blocked contains packages which versions are lower than installed ones,
+	// while downgrade policy denies it. Such packages are not installed.
+	Blocked []DesiredPackage
+}
+
+// GroupPending reports whether packages defined by machine group are
+// pending installation.
+func (p Plan) GroupPending() bool {
+	return slices.ContainsFunc(p.Desired, func(pv DesiredPackage) bool {
+		return pv.Source == lifecyclev1alpha1.PackageSourceMachineGroup && pv.Pending()
+	})
+}
+
+// DesiredPackageVersions returns effective desired packages in the form
+// they are reflected in machine's status.
+func (p Plan) DesiredPackageVersions() []lifecyclev1alpha1.DesiredPackageVersion {
+	if len(p.Desired) == 0 {
+		return nil
+	}
+	result := make([]lifecyclev1alpha1.DesiredPackageVersion, len(p.Desired))
+	for i, pv := range p.Desired {
+		result[i] = lifecyclev1alpha1.DesiredPackageVersion{
+			Name:         pv.Name,
+			Version:      pv.Version,
+			Source:       pv.Source,
+			MachineGroup: pv.MachineGroup,
+			Constraint:   pv.Constraint,
+		}
+	}
+	return result
+}
+
+// GroupConflictError is returned if several machine groups with the same
+// highest priority match the machine.
+type GroupConflictError struct {
+	Priority int32
+	Groups   []string
+}
+
+func (e *GroupConflictError) Error() string {
+	return fmt.Sprintf("machine groups %s match the machine with the same priority %d",
+		strings.Join(e.Groups, ", "), e.Priority)
+}
+
+// MachineGroup returns the machine group of the machine type with the
+// highest priority, which selector matches labels of the machine. If several
+// groups with the highest priority match, GroupConflictError is returned.
+func MachineGroup(
+	machine *lifecyclev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+) (*lifecyclev1alpha1.MachineGroup, error) {
+	var matched []int
+	for i := range machineType.Spec.MachineGroups {
+		group := &machineType.Spec.MachineGroups[i]
+		selector, err := metav1.LabelSelectorAsSelector(&group.MachineSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of machine group %s: %w", groupName(i, group), err)
+		}
+		if !selector.Matches(labels.Set(machine.Labels)) {
+			continue
+		}
+		switch {
+		case len(matched) == 0 || group.Priority > machineType.Spec.MachineGroups[matched[0]].Priority:
+			matched = []int{i}
+		case group.Priority == machineType.Spec.MachineGroups[matched[0]].Priority:
+			matched = append(matched, i)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &machineType.Spec.MachineGroups[matched[0]], nil
+	}
+	conflict := &GroupConflictError{Priority: machineType.Spec.MachineGroups[matched[0]].Priority}
+	for _, i := range matched {
+		conflict.Groups = append(conflict.Groups, groupName(i, &machineType.Spec.MachineGroups[i]))
+	}
+	return nil, conflict
+}
+
+// groupName returns the name of machine group or its index if the name is
+// not set.
+func groupName(idx int, group *lifecyclev1alpha1.MachineGroup) string {
+	if group.Name != "" {
+		return strconv.Quote(group.Name)
+	}
+	return "#" + strconv.Itoa(idx)
+}
+
+// Compute returns the install plan of the machine considering packages
+// defined in machine's spec, packages defined by machine group and packages
+// installed on the machine.
+func Compute(machine *lifecyclev1alpha1.Machine, machineType *lifecyclev1alpha1.MachineType) (Plan, error) {
+	group, err := MachineGroup(machine, machineType)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	desired := make([]DesiredPackage, 0, len(machine.Spec.Packages))
+	for _, pv := range machine.Spec.Packages {
+		desired = append(desired, DesiredPackage{PackageVersion: pv, Source: lifecyclev1alpha1.PackageSourceMachine})
+	}
+	if group != nil {
+		for _, pv := range group.Packages {
+			if slices.ContainsFunc(machine.Spec.Packages, func(packageVersion lifecyclev1alpha1.PackageVersion) bool {
+				return pv.Name == packageVersion.Name
+			}) {
+				continue
+			}
+			desired = append(desired, DesiredPackage{
+				PackageVersion: pv,
+				Source:         lifecyclev1alpha1.PackageSourceMachineGroup,
+				MachineGroup:   group.Name,
+			})
+		}
+	}
+
+	plan := Plan{Group: group}
+	allowDowngrade := DowngradeAllowed(machine, DowngradePolicy(machineType, group))
+	scheme := versionutil.ForManufacturer(machineType.Spec.Manufacturer)
+	installed := machine.Status.InstalledPackages
+	for _, pv := range desired {
+		if versionutil.IsConstraint(pv.Version) {
+			pv = resolve(pv, scheme, machineType)
+			if pv.Unresolved != "" {
+				plan.Unresolved = append(plan.Unresolved, pv)
+				continue
+			}
+		}
+		idx := slices.IndexFunc(installed, func(packageVersion lifecyclev1alpha1.PackageVersion) bool {
+			return pv.Name == packageVersion.Name
+		})
+		if idx >= 0 {
+			pv.InstalledVersion = installed[idx].Version
+			pv.Downgrade = IsDowngrade(scheme, pv.Version, pv.InstalledVersion)
+			pv.Blocked = pv.Downgrade && !allowDowngrade
+		}
+		plan.Desired = append(plan.Desired, pv)
+		if pv.Blocked {
+			plan.Blocked = append(plan.Blocked, pv)
+		}
+		if pv.Pending() {
+			plan.Pending = append(plan.Pending, pv.PackageVersion)
+		}
+	}
+	return plan, nil
+}
+
+// IsDowngrade reports whether the desired version is lower than the installed
+// one. Versions which are not comparable are not considered downgrade.
+func IsDowngrade(scheme versionutil.Scheme, desired, installed string) bool {
+	c, err := scheme.Compare(desired, installed)
+	return err == nil && c < 0
+}
+
+// DowngradePolicy returns the downgrade policy effective for machines of the
+// group. Policy of the group takes precedence over policy of the machine type,
+// downgrade is denied if neither defines the policy.
+func DowngradePolicy(
+	machineType *lifecyclev1alpha1.MachineType,
+	group *lifecyclev1alpha1.MachineGroup,
+) lifecyclev1alpha1.DowngradePolicy {
+	switch {
+	case group != nil && group.DowngradePolicy != "":
+		return group.DowngradePolicy
+	case machineType.Spec.DowngradePolicy != "":
+		return machineType.Spec.DowngradePolicy
+	}
+	return lifecyclev1alpha1.DowngradePolicyDeny
+}
+
+// DowngradeAllowed reports whether packages of the machine might be
+// downgraded according to the policy.
+func DowngradeAllowed(machine *lifecyclev1alpha1.Machine, policy lifecyclev1alpha1.DowngradePolicy) bool {
+	switch policy {
+	case lifecyclev1alpha1.DowngradePolicyAllow:
+		return true
+	case lifecyclev1alpha1.DowngradePolicyAllowWithAnnotation:
+		return machine.Annotations[lifecyclev1alpha1.AllowDowngradeAnnotation] == "true"
+	}
+	return false
+}
+
+// resolve resolves version constraint of the package against versions
+// available for the machine type.
+func resolve(
+	pv DesiredPackage,
+	scheme versionutil.Scheme,
+	machineType *lifecyclev1alpha1.MachineType,
+) DesiredPackage {
+	pv.Constraint = pv.Version
+	constraint, err := versionutil.ParseConstraint(pv.Constraint)
+	if err != nil {
+		pv.Unresolved = err.Error()
+		return pv
+	}
+	var available []string
+	for _, entry := range machineType.Status.AvailablePackages {
+		if entry.Name == pv.Name {
+			available = entry.Versions
+			break
+		}
+	}
+	version, ok := constraint.Resolve(scheme, available)
+	if !ok {
+		pv.Unresolved = fmt.Sprintf("no available version of package %s matches %q", pv.Name, pv.Constraint)
+		return pv
+	}
+	pv.Version = version
+	return pv
+}
@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package planutil
+
+import (
+	"errors"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Install plan", func() {
+	machineType := &lifecyclev1alpha1.MachineType{
+		Spec: lifecyclev1alpha1.MachineTypeSpec{
+			MachineGroups: []lifecyclev1alpha1.MachineGroup{
+				{
+					Name:            "canary",
+					MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "canary"}},
+					Packages:        []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "3.0.0"}},
+				},
+				{
+					Name:            "production",
+					MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					Packages: []lifecyclev1alpha1.PackageVersion{
+						{Name: "bios", Version: "2.0.0"},
+						{Name: "bmc", Version: "1.1.0"},
+						{Name: "nic", Version: "4.0.0"},
+					},
+				},
+			},
+		},
+	}
+
+	It("Should merge machine spec with group packages", func() {
+		machine := &lifecyclev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "prod"}},
+			Spec: lifecyclev1alpha1.MachineSpec{
+				Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.2.0"}},
+			},
+			Status: lifecyclev1alpha1.MachineStatus{
+				InstalledPackages: []lifecyclev1alpha1.PackageVersion{
+					{Name: "bios", Version: "1.0.0"},
+					{Name: "bmc", Version: "1.2.0"},
+				},
+			},
+		}
+		plan, err := Compute(machine, machineType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Desired).To(Equal([]DesiredPackage{
+			{
+				PackageVersion:   lifecyclev1alpha1.PackageVersion{Name: "bmc", Version: "1.2.0"},
+				Source:           lifecyclev1alpha1.PackageSourceMachine,
+				InstalledVersion: "1.2.0",
+			},
+			{
+				PackageVersion:   lifecyclev1alpha1.PackageVersion{Name: "bios", Version: "2.0.0"},
+				Source:           lifecyclev1alpha1.PackageSourceMachineGroup,
+				MachineGroup:     "production",
+				InstalledVersion: "1.0.0",
+			},
+			{
+				PackageVersion: lifecyclev1alpha1.PackageVersion{Name: "nic", Version: "4.0.0"},
+				Source:         lifecyclev1alpha1.PackageSourceMachineGroup,
+				MachineGroup:   "production",
+			},
+		}))
+		Expect(plan.Pending).To(Equal([]lifecyclev1alpha1.PackageVersion{
+			{Name: "bios", Version: "2.0.0"},
+			{Name: "nic", Version: "4.0.0"},
+		}))
+		Expect(plan.DesiredPackageVersions()).To(Equal([]lifecyclev1alpha1.DesiredPackageVersion{
+			{Name: "bmc", Version: "1.2.0", Source: lifecyclev1alpha1.PackageSourceMachine},
+			{Name: "bios", Version: "2.0.0", Source: lifecyclev1alpha1.PackageSourceMachineGroup, MachineGroup: "production"},
+			{Name: "nic", Version: "4.0.0", Source: lifecyclev1alpha1.PackageSourceMachineGroup, MachineGroup: "production"},
+		}))
+	})
+
+	It("Should consider machine spec only if no group matches", func() {
+		machine := &lifecyclev1alpha1.Machine{
+			Spec: lifecyclev1alpha1.MachineSpec{
+				Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.2.0"}},
+			},
+		}
+		group, err := MachineGroup(machine, machineType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(group).To(BeNil())
+
+		plan, err := Compute(machine, machineType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Desired).To(HaveLen(1))
+		Expect(plan.Pending).To(Equal([]lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.2.0"}}))
+	})
+
+	It("Should have no pending packages if everything is installed", func() {
+		machine := &lifecyclev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "canary"}},
+			Status: lifecyclev1alpha1.MachineStatus{
+				InstalledPackages: []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "3.0.0"}},
+			},
+		}
+		plan, err := Compute(machine, machineType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Desired).To(HaveLen(1))
+		Expect(plan.Desired[0].MachineGroup).To(Equal("canary"))
+		Expect(plan.Pending).To(BeEmpty())
+	})
+
+	Context("Machine group selection", func() {
+		machineType := &lifecyclev1alpha1.MachineType{
+			Spec: lifecyclev1alpha1.MachineTypeSpec{
+				MachineGroups: []lifecyclev1alpha1.MachineGroup{
+					{
+						Name: "all",
+						MachineSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "env", Operator: metav1.LabelSelectorOpExists},
+						}},
+					},
+					{
+						Name: "non-production",
+						MachineSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"prod"}},
+						}},
+						Priority: 10,
+					},
+					{
+						Name: "lab",
+						MachineSelector: metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"lab", "dev"}},
+						}},
+						Priority: 10,
+					},
+				},
+			},
+		}
+		machineWithEnv := func(env string) *lifecyclev1alpha1.Machine {
+			return &lifecyclev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": env}}}
+		}
+
+		It("Should evaluate match expressions", func() {
+			group, err := MachineGroup(machineWithEnv("prod"), machineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Name).To(Equal("all"))
+
+			// NotIn matches machines without the label as well
+			group, err = MachineGroup(&lifecyclev1alpha1.Machine{}, machineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Name).To(Equal("non-production"))
+		})
+
+		It("Should prefer group with higher priority", func() {
+			group, err := MachineGroup(machineWithEnv("staging"), machineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(group.Name).To(Equal("non-production"))
+		})
+
+		It("Should report conflict of groups with the same priority", func() {
+			_, err := MachineGroup(machineWithEnv("lab"), machineType)
+			var conflict *GroupConflictError
+			Expect(errors.As(err, &conflict)).To(BeTrue())
+			Expect(conflict.Priority).To(Equal(int32(10)))
+			Expect(conflict.Groups).To(Equal([]string{`"non-production"`, `"lab"`}))
+
+			_, err = Compute(machineWithEnv("dev"), machineType)
+			Expect(errors.As(err, &conflict)).To(BeTrue())
+		})
+	})
+
+	Context("Version constraints", func() {
+		machineType := &lifecyclev1alpha1.MachineType{
+			Spec: lifecyclev1alpha1.MachineTypeSpec{
+				MachineGroups: []lifecyclev1alpha1.MachineGroup{{
+					Name:     "all",
+					Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "~2.8"}},
+				}},
+			},
+			Status: lifecyclev1alpha1.MachineTypeStatus{
+				AvailablePackages: []lifecyclev1alpha1.AvailablePackageVersions{
+					{Name: "bios", Versions: []string{"2.7.0", "2.8.0", "2.8.4", "2.9.0"}},
+					{Name: "bmc", Versions: []string{"1.0.0", "1.1.0"}},
+				},
+			},
+		}
+
+		It("Should resolve constraints against available versions", func() {
+			machine := &lifecyclev1alpha1.Machine{
+				Spec: lifecyclev1alpha1.MachineSpec{
+					Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "latest"}},
+				},
+				Status: lifecyclev1alpha1.MachineStatus{
+					InstalledPackages: []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.1.0"}},
+				},
+			}
+			plan, err := Compute(machine, machineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Unresolved).To(BeEmpty())
+			Expect(plan.DesiredPackageVersions()).To(Equal([]lifecyclev1alpha1.DesiredPackageVersion{
+				{Name: "bmc", Version: "1.1.0", Source: lifecyclev1alpha1.PackageSourceMachine, Constraint: "latest"},
+				{
+					Name:         "bios",
+					Version:      "2.8.4",
+					Source:       lifecyclev1alpha1.PackageSourceMachineGroup,
+					MachineGroup: "all",
+					Constraint:   "~2.8",
+				},
+			}))
+			Expect(plan.Pending).To(Equal([]lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.8.4"}}))
+		})
+
+		It("Should report constraints matching no available version", func() {
+			machine := &lifecyclev1alpha1.Machine{
+				Spec: lifecyclev1alpha1.MachineSpec{
+					Packages: []lifecyclev1alpha1.PackageVersion{
+						{Name: "bmc", Version: ">=2.0"},
+						{Name: "nic", Version: "latest"},
+					},
+				},
+			}
+			plan, err := Compute(machine, machineType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Desired).To(HaveLen(1))
+			Expect(plan.Unresolved).To(HaveLen(2))
+			Expect(plan.Unresolved[0].Unresolved).To(ContainSubstring(`package bmc matches ">=2.0"`))
+			Expect(plan.Unresolved[1].Name).To(Equal("nic"))
+		})
+	})
+
+	Context("Vendor versions", func() {
+		lenovo := &lifecyclev1alpha1.MachineType{
+			Spec: lifecyclev1alpha1.MachineTypeSpec{Manufacturer: "Lenovo"},
+			Status: lifecyclev1alpha1.MachineTypeStatus{
+				AvailablePackages: []lifecyclev1alpha1.AvailablePackageVersions{
+					{Name: "uefi", Versions: []string{"TEE142L", "TEE156K", "TEE180J"}},
+				},
+			},
+		}
+
+		It("Should resolve constraints according to manufacturer's scheme", func() {
+			machine := &lifecyclev1alpha1.Machine{
+				Spec: lifecyclev1alpha1.MachineSpec{
+					Packages: []lifecyclev1alpha1.PackageVersion{{Name: "uefi", Version: "<TEE160A"}},
+				},
+			}
+			plan, err := Compute(machine, lenovo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Pending).To(Equal([]lifecyclev1alpha1.PackageVersion{{Name: "uefi", Version: "TEE156K"}}))
+		})
+
+		It("Should detect downgrade", func() {
+			machine := &lifecyclev1alpha1.Machine{
+				Spec: lifecyclev1alpha1.MachineSpec{
+					Packages: []lifecyclev1alpha1.PackageVersion{
+						{Name: "uefi", Version: "TEE142L"},
+						{Name: "xcc", Version: "TEI392P"},
+					},
+				},
+				Status: lifecyclev1alpha1.MachineStatus{
+					InstalledPackages: []lifecyclev1alpha1.PackageVersion{
+						{Name: "uefi", Version: "TEE156K"},
+						{Name: "xcc", Version: "TEI380A"},
+					},
+				},
+			}
+			plan, err := Compute(machine, lenovo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.Desired).To(HaveLen(2))
+			Expect(plan.Desired[0].Downgrade).To(BeTrue())
+			Expect(plan.Desired[1].Downgrade).To(BeFalse())
+		})
+	})
+
+	Context("Downgrade policy", func() {
+		machine := func(annotations map[string]string) *lifecyclev1alpha1.Machine {
+			return &lifecyclev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"env": "prod"}, Annotations: annotations},
+				Spec: lifecyclev1alpha1.MachineSpec{
+					Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.0.0"}},
+				},
+				Status: lifecyclev1alpha1.MachineStatus{
+					InstalledPackages: []lifecyclev1alpha1.PackageVersion{{Name: "bmc", Version: "1.1.0"}},
+				},
+			}
+		}
+		machineType := func(typePolicy, groupPolicy lifecyclev1alpha1.DowngradePolicy) *lifecyclev1alpha1.MachineType {
+			return &lifecyclev1alpha1.MachineType{
+				Spec: lifecyclev1alpha1.MachineTypeSpec{
+					DowngradePolicy: typePolicy,
+					MachineGroups: []lifecyclev1alpha1.MachineGroup{{
+						Name:            "production",
+						MachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+						DowngradePolicy: groupPolicy,
+					}},
+				},
+			}
+		}
+		allowed := map[string]string{lifecyclev1alpha1.AllowDowngradeAnnotation: "true"}
+
+		DescribeTable("Blocking downgrades",
+			func(m *lifecyclev1alpha1.Machine, mt *lifecyclev1alpha1.MachineType, blocked bool) {
+				plan, err := Compute(m, mt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(plan.Desired).To(HaveLen(1))
+				Expect(plan.Desired[0].Downgrade).To(BeTrue())
+				Expect(plan.Desired[0].Blocked).To(Equal(blocked))
+				if blocked {
+					Expect(plan.Blocked).To(HaveLen(1))
+					Expect(plan.Pending).To(BeEmpty())
+				} else {
+					Expect(plan.Blocked).To(BeEmpty())
+					Expect(plan.Pending).To(HaveLen(1))
+				}
+			},
+			Entry("denied by default", machine(nil), machineType("", ""), true),
+			Entry("allowed by machine type", machine(nil), machineType(lifecyclev1alpha1.DowngradePolicyAllow, ""), false),
+			Entry("denied by group despite machine type",
+				machine(nil), machineType(lifecyclev1alpha1.DowngradePolicyAllow, lifecyclev1alpha1.DowngradePolicyDeny), true),
+			Entry("allowed with annotation",
+				machine(allowed), machineType("", lifecyclev1alpha1.DowngradePolicyAllowWithAnnotation), false),
+			Entry("denied without annotation",
+				machine(nil), machineType("", lifecyclev1alpha1.DowngradePolicyAllowWithAnnotation), true),
+			Entry("annotation ignored if denied",
+				machine(allowed), machineType(lifecyclev1alpha1.DowngradePolicyDeny, ""), true),
+		)
+	})
+})
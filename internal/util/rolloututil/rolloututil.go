@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rolloututil
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	"github.com/ironcore-dev/lifecycle-manager/internal/util/planutil"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Revision returns the revision identifying packages of the machine group.
+// Revision changes whenever any package version of the group changes.
+func Revision(group *lifecyclev1alpha1.MachineGroup) string {
+	h := fnv.New32a()
+	for _, pv := range group.Packages {
+		_, _ = fmt.Fprintf(h, "%s=%s\n", pv.Name, pv.Version)
+	}
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// Find returns the rollout status of the machine group, nil if not found.
+func Find(
+	rollouts []lifecyclev1alpha1.MachineGroupRolloutStatus,
+	name string,
+) *lifecyclev1alpha1.MachineGroupRolloutStatus {
+	idx := slices.IndexFunc(rollouts, func(status lifecyclev1alpha1.MachineGroupRolloutStatus) bool {
+		return status.Name == name
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &rollouts[idx]
+}
+
+// Admitted reports whether packages of the machine group might be installed
+// on the machine. Machines of groups without rollout strategy are always
+// admitted, otherwise the machine must be annotated with the current revision
+// of the group, while the rollout is not halted.
+func Admitted(
+	machine *lifecyclev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+	group *lifecyclev1alpha1.MachineGroup,
+) bool {
+	if group == nil || group.Name == "" || group.Rollout == nil {
+		return true
+	}
+	revision := Revision(group)
+	status := Find(machineType.Status.Rollouts, group.Name)
+	if status != nil && status.Revision == revision && status.Phase == lifecyclev1alpha1.RolloutPhaseHalted {
+		return false
+	}
+	return machine.Annotations[lifecyclev1alpha1.RolloutRevisionAnnotation] == revision
+}
+
+// Machine is the state of the machine of the group relevant to the rollout.
+type Machine struct {
+	Name string
+	// Pending reports whether packages of the group are not installed yet.
+	Pending bool
+	// Admitted reports whether the machine is admitted to install the
+	// current revision.
+	Admitted bool
+	// Failed reports whether the last installation failed.
+	Failed bool
+}
+
+// NewMachine returns the rollout state of the machine with the install plan.
+func NewMachine(machine *lifecyclev1alpha1.Machine, plan planutil.Plan, revision string) Machine {
+	return Machine{
+		Name:     machine.Name,
+		Pending:  plan.GroupPending(),
+		Admitted: machine.Annotations[lifecyclev1alpha1.RolloutRevisionAnnotation] == revision,
+		Failed: meta.IsStatusConditionTrue(
+			machine.Status.Conditions, lifecyclev1alpha1.MachineConditionInstallFailed),
+	}
+}
+
+// Result is the outcome of the rollout step.
+type Result struct {
+	// Status is the updated rollout status of the group.
+	Status lifecyclev1alpha1.MachineGroupRolloutStatus
+	// Admit contains names of machines admitted to the next batch.
+	Admit []string
+	// RequeueAfter is the remaining pause before the next batch.
+	RequeueAfter time.Duration
+}
+
+// Step advances the rollout of the group revision. Machines are admitted in
+// batches: the first batch is limited by the canary batch size if set, the
+// following ones by MaxUnavailable. The next batch is started once no
+// admitted machine is installing packages and the pause between batches is
+// over, failed machines don't count as installing. Rollout is halted once the
+// percentage of failed machines among admitted ones exceeds
+// MaxFailurePercentage, until the revision changes.
+// Group must define the rollout strategy.
+func Step(
+	group *lifecyclev1alpha1.MachineGroup,
+	previous *lifecyclev1alpha1.MachineGroupRolloutStatus,
+	machines []Machine,
+	now time.Time,
+) Result {
+	strategy := *group.Rollout
+	status := lifecyclev1alpha1.MachineGroupRolloutStatus{Name: group.Name, Revision: Revision(group)}
+	var previousPhase lifecyclev1alpha1.RolloutPhase
+	if previous != nil && previous.Revision == status.Revision {
+		previousPhase = previous.Phase
+		status.Batch = previous.Batch
+		status.LastTransitionTime = previous.LastTransitionTime
+	}
+
+	admitted, waiting := count(&status, machines)
+
+	result := Result{}
+	switch {
+	case previousPhase == lifecyclev1alpha1.RolloutPhaseHalted ||
+		status.Failed*100 > strategy.MaxFailurePercentage*admitted:
+		status.Phase = lifecyclev1alpha1.RolloutPhaseHalted
+		status.Message = fmt.Sprintf("%d of %d admitted machines failed to install packages",
+			status.Failed, admitted)
+	case status.Installing > 0:
+		// failed machines tolerated by MaxFailurePercentage don't block
+		// the next batch
+		status.Phase = lifecyclev1alpha1.RolloutPhaseProgressing
+	case len(waiting) == 0:
+		status.Phase = lifecyclev1alpha1.RolloutPhaseCompleted
+	case status.Batch > 0 && previousPhase != lifecyclev1alpha1.RolloutPhasePaused &&
+		strategy.PauseBetweenBatches.Duration > 0:
+		// the pause starts once the batch is finished
+		status.Phase = lifecyclev1alpha1.RolloutPhasePaused
+		result.RequeueAfter = strategy.PauseBetweenBatches.Duration
+	case status.Batch > 0 && previousPhase == lifecyclev1alpha1.RolloutPhasePaused &&
+		now.Before(status.LastTransitionTime.Add(strategy.PauseBetweenBatches.Duration)):
+		status.Phase = lifecyclev1alpha1.RolloutPhasePaused
+		result.RequeueAfter = status.LastTransitionTime.Add(strategy.PauseBetweenBatches.Duration).Sub(now)
+	default:
+		size := min(batchSize(strategy, status.Batch, status.Machines), len(waiting))
+		result.Admit = waiting[:size]
+		status.Batch++
+		status.Installing += int32(size)
+		status.Phase = lifecyclev1alpha1.RolloutPhaseProgressing
+	}
+	if status.Phase == lifecyclev1alpha1.RolloutPhasePaused {
+		status.Message = fmt.Sprintf("batch %d is finished, next batch starts after %s",
+			status.Batch, strategy.PauseBetweenBatches.Duration)
+	}
+	if status.Phase != previousPhase {
+		status.LastTransitionTime = metav1.NewTime(now)
+	}
+	result.Status = status
+	return result
+}
+
+// count counts machines of the group by their state and returns the number
+// of admitted machines and names of machines waiting for admission.
+func count(status *lifecyclev1alpha1.MachineGroupRolloutStatus, machines []Machine) (int32, []string) {
+	var admitted int32
+	var waiting []string
+	status.Machines = int32(len(machines))
+	for _, m := range machines {
+		if m.Admitted {
+			admitted++
+		}
+		switch {
+		case !m.Pending:
+			status.Updated++
+		case m.Admitted && m.Failed:
+			status.Failed++
+		case m.Admitted:
+			status.Installing++
+		default:
+			waiting = append(waiting, m.Name)
+		}
+	}
+	slices.Sort(waiting)
+	return admitted, waiting
+}
+
+// batchSize returns the number of machines admitted to the batch. The first
+// batch is limited by the canary batch size if set, other ones by
+// MaxUnavailable scaled to the number of machines, at least one machine is
+// admitted.
+func batchSize(strategy lifecyclev1alpha1.RolloutStrategy, batch, machines int32) int {
+	if batch == 0 && strategy.CanaryBatchSize > 0 {
+		return int(strategy.CanaryBatchSize)
+	}
+	maxUnavailable := intstr.FromInt32(1)
+	if strategy.MaxUnavailable != nil {
+		maxUnavailable = *strategy.MaxUnavailable
+	}
+	size, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, int(machines), true)
+	if err != nil || size < 1 {
+		return 1
+	}
+	return size
+}
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rolloututil
+
+import (
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Rollout", func() {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	newGroup := func(strategy lifecyclev1alpha1.RolloutStrategy) *lifecyclev1alpha1.MachineGroup {
+		return &lifecyclev1alpha1.MachineGroup{
+			Name:     "production",
+			Packages: []lifecyclev1alpha1.PackageVersion{{Name: "bios", Version: "2.0.0"}},
+			Rollout:  &strategy,
+		}
+	}
+	waiting := func(names ...string) []Machine {
+		result := make([]Machine, len(names))
+		for i, name := range names {
+			result[i] = Machine{Name: name, Pending: true}
+		}
+		return result
+	}
+
+	It("Should change revision with package versions", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{})
+		revision := Revision(group)
+		Expect(Revision(group)).To(Equal(revision))
+		group.Packages[0].Version = "2.1.0"
+		Expect(Revision(group)).NotTo(Equal(revision))
+	})
+
+	It("Should admit canary batch first", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{CanaryBatchSize: 1, MaxUnavailable: ptr.To(intstr.FromInt32(2))})
+		result := Step(group, nil, waiting("m3", "m1", "m2", "m4"), now)
+		Expect(result.Admit).To(Equal([]string{"m1"}))
+		Expect(result.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseProgressing))
+		Expect(result.Status.Batch).To(Equal(int32(1)))
+		Expect(result.Status.Installing).To(Equal(int32(1)))
+		Expect(result.Status.Machines).To(Equal(int32(4)))
+		Expect(result.Status.Revision).To(Equal(Revision(group)))
+	})
+
+	It("Should scale batch size by percentage of machines", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{MaxUnavailable: ptr.To(intstr.FromString("50%"))})
+		result := Step(group, nil, waiting("m1", "m2", "m3"), now)
+		Expect(result.Admit).To(Equal([]string{"m1", "m2"}))
+	})
+
+	It("Should admit one machine by default", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{})
+		result := Step(group, nil, waiting("m1", "m2"), now)
+		Expect(result.Admit).To(Equal([]string{"m1"}))
+	})
+
+	It("Should wait for the batch to finish", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{})
+		previous := Step(group, nil, waiting("m1", "m2"), now).Status
+		machines := waiting("m1", "m2")
+		machines[0].Admitted = true
+		result := Step(group, &previous, machines, now.Add(time.Minute))
+		Expect(result.Admit).To(BeEmpty())
+		Expect(result.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseProgressing))
+		Expect(result.Status.Installing).To(Equal(int32(1)))
+	})
+
+	It("Should pause between batches", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{PauseBetweenBatches: metav1.Duration{Duration: time.Hour}})
+		previous := Step(group, nil, waiting("m1", "m2"), now).Status
+		machines := []Machine{{Name: "m1", Admitted: true}, {Name: "m2", Pending: true}}
+
+		paused := Step(group, &previous, machines, now.Add(time.Minute))
+		Expect(paused.Admit).To(BeEmpty())
+		Expect(paused.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhasePaused))
+		Expect(paused.Status.Updated).To(Equal(int32(1)))
+		Expect(paused.RequeueAfter).To(Equal(time.Hour))
+
+		stillPaused := Step(group, &paused.Status, machines, now.Add(31*time.Minute))
+		Expect(stillPaused.Admit).To(BeEmpty())
+		Expect(stillPaused.RequeueAfter).To(Equal(30 * time.Minute))
+
+		resumed := Step(group, &stillPaused.Status, machines, now.Add(61*time.Minute))
+		Expect(resumed.Admit).To(Equal([]string{"m2"}))
+		Expect(resumed.Status.Batch).To(Equal(int32(2)))
+	})
+
+	It("Should halt once failure percentage is exceeded", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{MaxFailurePercentage: 50})
+		machines := []Machine{
+			{Name: "m1", Pending: true, Admitted: true, Failed: true},
+			{Name: "m2", Admitted: true},
+			{Name: "m3", Pending: true},
+		}
+		previous := lifecyclev1alpha1.MachineGroupRolloutStatus{
+			Name:     group.Name,
+			Revision: Revision(group),
+			Phase:    lifecyclev1alpha1.RolloutPhaseProgressing,
+			Batch:    2,
+		}
+		result := Step(group, &previous, machines, now)
+		Expect(result.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseProgressing))
+
+		machines[1] = Machine{Name: "m2", Pending: true, Admitted: true, Failed: true}
+		halted := Step(group, &result.Status, machines, now)
+		Expect(halted.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseHalted))
+		Expect(halted.Status.Failed).To(Equal(int32(2)))
+		Expect(halted.Admit).To(BeEmpty())
+
+		By("keeping the rollout halted until the revision changes")
+		machines[1].Failed = false
+		Expect(Step(group, &halted.Status, machines, now).Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseHalted))
+		group.Packages[0].Version = "2.1.0"
+		restarted := Step(group, &halted.Status, waiting("m1", "m2", "m3"), now)
+		Expect(restarted.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseProgressing))
+		Expect(restarted.Status.Batch).To(Equal(int32(1)))
+		Expect(restarted.Admit).To(Equal([]string{"m1"}))
+	})
+
+	It("Should start the next batch if failures are below the threshold", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{MaxFailurePercentage: 50})
+		machines := []Machine{
+			{Name: "m1", Pending: true, Admitted: true, Failed: true},
+			{Name: "m2", Admitted: true},
+			{Name: "m3", Pending: true},
+			{Name: "m4", Pending: true},
+		}
+		previous := lifecyclev1alpha1.MachineGroupRolloutStatus{
+			Name:     group.Name,
+			Revision: Revision(group),
+			Phase:    lifecyclev1alpha1.RolloutPhaseProgressing,
+			Batch:    1,
+		}
+		result := Step(group, &previous, machines, now)
+		Expect(result.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseProgressing))
+		Expect(result.Status.Failed).To(Equal(int32(1)))
+		Expect(result.Status.Batch).To(Equal(int32(2)))
+		Expect(result.Admit).To(Equal([]string{"m3"}))
+	})
+
+	It("Should complete once all machines are updated", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{})
+		result := Step(group, nil, []Machine{{Name: "m1"}, {Name: "m2"}}, now)
+		Expect(result.Status.Phase).To(Equal(lifecyclev1alpha1.RolloutPhaseCompleted))
+		Expect(result.Status.Updated).To(Equal(int32(2)))
+	})
+
+	It("Should admit machines with current revision only", func() {
+		group := newGroup(lifecyclev1alpha1.RolloutStrategy{})
+		machineType := &lifecyclev1alpha1.MachineType{}
+		machine := &lifecyclev1alpha1.Machine{}
+		Expect(Admitted(machine, machineType, group)).To(BeFalse())
+		Expect(Admitted(machine, machineType, &lifecyclev1alpha1.MachineGroup{Name: "staging"})).To(BeTrue())
+
+		machine.Annotations = map[string]string{lifecyclev1alpha1.RolloutRevisionAnnotation: Revision(group)}
+		Expect(Admitted(machine, machineType, group)).To(BeTrue())
+
+		machineType.Status.Rollouts = []lifecyclev1alpha1.MachineGroupRolloutStatus{{
+			Name:     group.Name,
+			Revision: Revision(group),
+			Phase:    lifecyclev1alpha1.RolloutPhaseHalted,
+		}}
+		Expect(Admitted(machine, machineType, group)).To(BeFalse())
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package selectorutil
+
+import (
+	"fmt"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MachineFields returns the set of fields Machine objects can be selected by.
+func MachineFields(in *lifecyclev1alpha1.Machine) fields.Set {
+	return fields.Set{
+		"metadata.name":            in.Name,
+		"metadata.namespace":       in.Namespace,
+		"spec.machineTypeRef.name": in.Spec.MachineTypeRef.Name,
+		"spec.oobMachineRef.name":  in.Spec.OOBMachineRef.Name,
+		"status.lastScanResult":    string(in.Status.LastScanResult),
+	}
+}
+
+// MachineTypeFields returns the set of fields MachineType objects can be selected by.
+func MachineTypeFields(in *lifecyclev1alpha1.MachineType) fields.Set {
+	return fields.Set{
+		"metadata.name":         in.Name,
+		"metadata.namespace":    in.Namespace,
+		"spec.manufacturer":     in.Spec.Manufacturer,
+		"spec.type":             in.Spec.Type,
+		"status.lastScanResult": string(in.Status.LastScanResult),
+	}
+}
+
+// LabelSelector converts label selector to selector, considering both
+// matchLabels and matchExpressions. Nil selector matches everything.
+func LabelSelector(in *metav1.LabelSelector) (labels.Selector, error) {
+	if in == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(in)
+}
+
+// FieldSelector parses field selector and ensures it refers only to fields
+// from the supported set.
+func FieldSelector(in string, supported fields.Set) (fields.Selector, error) {
+	if in == "" {
+		return fields.Everything(), nil
+	}
+	selector, err := fields.ParseSelector(in)
+	if err != nil {
+		return nil, err
+	}
+	for _, requirement := range selector.Requirements() {
+		if !supported.Has(requirement.Field) {
+			return nil, fmt.Errorf("field %q is not supported in field selector", requirement.Field)
+		}
+	}
+	return selector, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package selectorutil
+
+import (
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("Selectors", func() {
+	machine := &lifecyclev1alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-machine",
+			Namespace: "metal",
+			Labels:    map[string]string{"env": "prod", "rack": "r1"},
+		},
+		Spec: lifecyclev1alpha1.MachineSpec{
+			MachineTypeRef: corev1.LocalObjectReference{Name: "sample-machinetype"},
+		},
+		Status: lifecyclev1alpha1.MachineStatus{LastScanResult: lifecyclev1alpha1.ScanFailure},
+	}
+
+	Context("Label selector", func() {
+		It("Should match everything when selector is nil", func() {
+			selector, err := LabelSelector(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.Empty()).To(BeTrue())
+		})
+
+		It("Should consider match expressions", func() {
+			selector, err := LabelSelector(&metav1.LabelSelector{
+				MatchLabels: map[string]string{"env": "prod"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "rack", Operator: metav1.LabelSelectorOpIn, Values: []string{"r2", "r3"}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.Matches(labels.Set(machine.Labels))).To(BeFalse())
+			Expect(selector.String()).To(Equal("env=prod,rack in (r2,r3)"))
+		})
+
+		It("Should fail on invalid operator", func() {
+			_, err := LabelSelector(&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "rack", Operator: "Like"}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Field selector", func() {
+		It("Should match supported fields", func() {
+			selector, err := FieldSelector(
+				"spec.machineTypeRef.name=sample-machinetype,status.lastScanResult!=Success", MachineFields(machine))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.Matches(MachineFields(machine))).To(BeTrue())
+		})
+
+		It("Should reject unsupported fields", func() {
+			_, err := FieldSelector("spec.scanPeriod=1h", MachineFields(machine))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should reject malformed selector", func() {
+			_, err := FieldSelector("spec.machineTypeRef.name", MachineFields(machine))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
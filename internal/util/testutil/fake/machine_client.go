@@ -43,7 +43,7 @@ func (c *MachineClient) Install(
 ) (*connect.Response[machineapiv1alpha1.InstallResponse], error) {
 	in := req.Msg
 	switch {
-	case in.Name == "sample-install-submitted":
+	case in.Name == "sample-install-submitted", in.Name == "drift-reconverge":
 		return connect.NewResponse(&machineapiv1alpha1.InstallResponse{
 			Result: commonv1alpha1.RequestResult_REQUEST_RESULT_SCHEDULED,
 		}), nil
@@ -66,6 +66,13 @@ func (c *MachineClient) UpdateMachineStatus(
 	return nil, nil
 }
 
+func (c *MachineClient) UpdatePackageStatus(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.UpdatePackageStatusRequest],
+) (*connect.Response[machineapiv1alpha1.UpdatePackageStatusResponse], error) {
+	return nil, nil
+}
+
 func (c *MachineClient) ListMachines(
 	_ context.Context,
 	_ *connect.Request[machineapiv1alpha1.ListMachinesRequest],
@@ -100,3 +107,45 @@ func (c *MachineClient) GetJob(
 ) (*connect.Response[machineapiv1alpha1.GetJobResponse], error) {
 	return nil, nil
 }
+
+func (c *MachineClient) ScanMachines(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.ScanMachinesRequest],
+) (*connect.Response[machineapiv1alpha1.ScanMachinesResponse], error) {
+	return nil, nil
+}
+
+func (c *MachineClient) InstallMachines(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.InstallMachinesRequest],
+) (*connect.Response[machineapiv1alpha1.InstallMachinesResponse], error) {
+	return nil, nil
+}
+
+func (c *MachineClient) SetPackageVersions(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.SetPackageVersionsRequest],
+) (*connect.Response[machineapiv1alpha1.SetPackageVersionsResponse], error) {
+	return nil, nil
+}
+
+func (c *MachineClient) GetInstallPlan(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.GetInstallPlanRequest],
+) (*connect.Response[machineapiv1alpha1.GetInstallPlanResponse], error) {
+	return nil, nil
+}
+
+func (c *MachineClient) ApproveInstall(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.ApproveInstallRequest],
+) (*connect.Response[machineapiv1alpha1.ApproveInstallResponse], error) {
+	return nil, nil
+}
+
+func (c *MachineClient) GetMachineHistory(
+	_ context.Context,
+	_ *connect.Request[machineapiv1alpha1.GetMachineHistoryRequest],
+) (*connect.Response[machineapiv1alpha1.GetMachineHistoryResponse], error) {
+	return nil, nil
+}
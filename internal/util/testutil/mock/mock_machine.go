@@ -51,6 +51,11 @@ func (b *MachineMockBuilder) WithLastScanTime(timestamp metav1.Time) *MachineMoc
 	return b
 }
 
+func (b *MachineMockBuilder) WithConditions(conditions ...metav1.Condition) *MachineMockBuilder {
+	b.inner.Status.Conditions = append(b.inner.Status.Conditions, conditions...)
+	return b
+}
+
 func (b *MachineMockBuilder) Complete() *lifecyclev1alpha1.Machine {
 	return b.inner
 }
@@ -26,6 +26,13 @@ func (b *MachineTypeMockBuilder) WithMachineGroups(groups []lifecyclev1alpha1.Ma
 	return b
 }
 
+func (b *MachineTypeMockBuilder) WithMaintenanceWindows(
+	windows []lifecyclev1alpha1.MaintenanceWindow,
+) *MachineTypeMockBuilder {
+	b.inner.Spec.MaintenanceWindows = windows
+	return b
+}
+
 func (b *MachineTypeMockBuilder) Complete() *lifecyclev1alpha1.MachineType {
 	return b.inner
 }
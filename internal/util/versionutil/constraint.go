@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package versionutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Latest is the constraint matching the highest available version.
+const Latest = "latest"
+
+type operator string
+
+const (
+	opEqual        operator = "="
+	opNotEqual     operator = "!="
+	opGreater      operator = ">"
+	opGreaterEqual operator = ">="
+	opLess         operator = "<"
+	opLessEqual    operator = "<="
+	opTilde        operator = "~"
+	opCaret        operator = "^"
+)
+
+// operators are ordered so that longer operators are matched first.
+var operators = []operator{opNotEqual, opGreaterEqual, opLessEqual, opEqual, opGreater, opLess, opTilde, opCaret}
+
+type requirement struct {
+	op      operator
+	version string
+}
+
+func (r requirement) matches(scheme Scheme, v string) bool {
+	c, err := scheme.Compare(v, r.version)
+	if err != nil {
+		return false
+	}
+	switch r.op {
+	case opEqual:
+		return c == 0
+	case opNotEqual:
+		return c != 0
+	case opGreater:
+		return c > 0
+	case opGreaterEqual:
+		return c >= 0
+	case opLess:
+		return c < 0
+	case opLessEqual:
+		return c <= 0
+	}
+	return false
+}
+
+// Constraint is the version constraint expression. Expression consists of
+// alternatives separated by "||", each alternative is a space separated list
+// of requirements, which all must be satisfied, e.g. ">=2.8.0 <3.0.0". Tilde
+// requirement "~2.8" allows patch releases, caret requirement "^2.8" allows
+// minor releases, both require dotted numeric version. Expression "latest"
+// matches any version. Versions are compared according to the versioning
+// scheme of the manufacturer.
+type Constraint struct {
+	expr         string
+	latest       bool
+	alternatives [][]requirement
+}
+
+// IsConstraint reports whether the version string is a constraint expression
+// rather than an exact version. Exact versions might contain spaces, thus
+// only expressions starting with an operator are considered constraints.
+func IsConstraint(in string) bool {
+	s := strings.TrimSpace(in)
+	if s == Latest || strings.Contains(s, "||") {
+		return true
+	}
+	for _, op := range operators {
+		if strings.HasPrefix(s, string(op)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseConstraint parses constraint expression.
+func ParseConstraint(in string) (*Constraint, error) {
+	c := &Constraint{expr: in}
+	s := strings.TrimSpace(in)
+	if s == Latest {
+		c.latest = true
+		return c, nil
+	}
+	for _, alternative := range strings.Split(s, "||") {
+		fields := strings.Fields(alternative)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid constraint %q: empty alternative", in)
+		}
+		var requirements []requirement
+		for _, field := range fields {
+			parsed, err := parseRequirement(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint %q: %w", in, err)
+			}
+			requirements = append(requirements, parsed...)
+		}
+		c.alternatives = append(c.alternatives, requirements)
+	}
+	return c, nil
+}
+
+func parseRequirement(in string) ([]requirement, error) {
+	op := opEqual
+	for _, candidate := range operators {
+		if strings.HasPrefix(in, string(candidate)) {
+			op = candidate
+			break
+		}
+	}
+	raw := strings.TrimPrefix(in, string(op))
+	if op != opTilde && op != opCaret {
+		if _, err := tokenize(raw); err != nil {
+			return nil, err
+		}
+		return []requirement{{op: op, version: raw}}, nil
+	}
+	v, err := parseVersion(raw)
+	if err != nil {
+		return nil, err
+	}
+	idx := 0
+	if op == opTilde {
+		// ~2 allows minor releases, ~2.8 and ~2.8.1 allow patch releases
+		idx = min(len(v.numbers)-1, 1)
+	} else {
+		// ^2.8 allows minor releases, ^0.2 allows patch releases only
+		for idx < len(v.numbers)-1 && v.numbers[idx] == 0 {
+			idx++
+		}
+	}
+	return []requirement{
+		{op: opGreaterEqual, version: v.String()},
+		{op: opLess, version: v.bump(idx).String()},
+	}, nil
+}
+
+// String returns the original expression.
+func (c *Constraint) String() string {
+	return c.expr
+}
+
+// Check reports whether the version satisfies the constraint. Versions which
+// are not comparable with required ones satisfy only "latest" constraint.
+func (c *Constraint) Check(scheme Scheme, in string) bool {
+	if c.latest {
+		return true
+	}
+	for _, requirements := range c.alternatives {
+		if matchesAll(scheme, requirements, in) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAll(scheme Scheme, requirements []requirement, v string) bool {
+	for _, r := range requirements {
+		if !r.matches(scheme, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve returns the highest of available versions satisfying the
+// constraint. Invalid versions are resolved only if no valid version
+// satisfies the constraint. Returns false if none of versions satisfies the
+// constraint.
+func (c *Constraint) Resolve(scheme Scheme, available []string) (string, bool) {
+	var result string
+	resultValid := false
+	for _, candidate := range available {
+		if !c.Check(scheme, candidate) {
+			continue
+		}
+		_, err := scheme.Compare(candidate, candidate)
+		candidateValid := err == nil
+		if result == "" || candidateValid && !resultValid {
+			result, resultValid = candidate, candidateValid
+			continue
+		}
+		if !candidateValid && resultValid {
+			continue
+		}
+		// available versions are sorted, so the later discovered one of
+		// versions which are not comparable is considered the latest
+		if cmp, err := scheme.Compare(candidate, result); err != nil || cmp > 0 {
+			result = candidate
+		}
+	}
+	return result, result != ""
+}
+
+// Validate ensures the version is either an exact version or a valid
+// constraint expression.
+func Validate(in string) error {
+	if !IsConstraint(in) {
+		return nil
+	}
+	_, err := ParseConstraint(in)
+	return err
+}
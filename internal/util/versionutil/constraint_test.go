@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package versionutil
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Version constraints", func() {
+	DescribeTable("Detecting constraints",
+		func(in string, expected bool) {
+			Expect(IsConstraint(in)).To(Equal(expected))
+		},
+		Entry("exact version", "2.8.0", false),
+		Entry("exact version with spaces", "U30 v2.80", false),
+		Entry("latest", "latest", true),
+		Entry("range", ">=2.8.0 <3.0.0", true),
+		Entry("tilde", "~2.8", true),
+		Entry("alternatives", "2.8.0 || 3.0.0", true),
+	)
+
+	DescribeTable("Checking versions",
+		func(constraint, version string, expected bool) {
+			c, err := ParseConstraint(constraint)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Check(Generic, version)).To(Equal(expected))
+		},
+		Entry("range includes lower bound", ">=2.8.0 <3.0.0", "2.8.0", true),
+		Entry("range excludes upper bound", ">=2.8.0 <3.0.0", "3.0.0", false),
+		Entry("range includes pre-release of upper bound", ">=2.8.0 <3.0.0", "3.0.0-rc.1", true),
+		Entry("tilde allows patch release", "~2.8", "2.8.9", true),
+		Entry("tilde denies minor release", "~2.8", "2.9.0", false),
+		Entry("tilde with major only allows minor release", "~2", "2.9.0", true),
+		Entry("caret allows minor release", "^2.8", "2.19.1", true),
+		Entry("caret denies major release", "^2.8", "3.0.0", false),
+		Entry("caret of zero major denies minor release", "^0.2.3", "0.3.0", false),
+		Entry("not equal", "!=2.8.1", "2.8.1", false),
+		Entry("alternatives", "~1.2 || ~2.8", "1.2.5", true),
+		Entry("short version equals padded one", "=2.8", "2.8.0", true),
+		Entry("leading v", ">=2.8", "v2.8.1", true),
+		Entry("not comparable version", ">=2.8", "TEE156K", false),
+		Entry("latest matches anything", "latest", "TEE156K", true),
+	)
+
+	DescribeTable("Parsing invalid constraints",
+		func(constraint string) {
+			_, err := ParseConstraint(constraint)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("not a version", ">=abc"),
+		Entry("empty alternative", ">=2.8 ||"),
+		Entry("missing version", "~"),
+	)
+
+	It("Should resolve the highest matching version", func() {
+		available := []string{"2.7.4", "2.8.0", "2.8.3", "2.10.0", "3.0.0"}
+		for constraint, expected := range map[string]string{
+			">=2.8.0 <3.0.0": "2.10.0",
+			"~2.8":           "2.8.3",
+			"latest":         "3.0.0",
+			"<2.8":           "2.7.4",
+		} {
+			c, err := ParseConstraint(constraint)
+			Expect(err).NotTo(HaveOccurred())
+			resolved, ok := c.Resolve(Generic, available)
+			Expect(ok).To(BeTrue(), constraint)
+			Expect(resolved).To(Equal(expected), constraint)
+		}
+
+		c, err := ParseConstraint(">=4.0")
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := c.Resolve(Generic, available)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package versionutil
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrNotComparable is returned if versions follow different versioning
+// schemes, so their order cannot be determined.
+var ErrNotComparable = errors.New("versions are not comparable")
+
+// Scheme defines ordering rules of firmware versions.
+type Scheme interface {
+	// Compare returns -1, 0 or 1 if a is lower, equal or greater than b.
+	Compare(a, b string) (int, error)
+}
+
+var (
+	// Generic is the fallback scheme. Dotted numeric versions are compared
+	// component-wise, other versions are split into numeric and alphabetic
+	// tokens, which are compared pairwise.
+	Generic Scheme = genericScheme{}
+	// Lenovo compares build IDs like TEE156K, which consist of product
+	// prefix, build number and revision letter, optionally followed by the
+	// version, e.g. TEE156K-2.91. Build IDs of different products are not
+	// comparable.
+	Lenovo Scheme = lenovoScheme{}
+	// Dell compares dotted numeric versions like 2.19.1, 4.40.00.00 or
+	// 51.16.0-4076, where the suffix is the build number rather than the
+	// pre-release, and legacy revisions like A07, where X-revisions precede
+	// A-revisions.
+	Dell Scheme = dellScheme{}
+	// HPE compares versions like "U30 v2.80 (10/16/2023)" or "iLO 5 v2.78",
+	// which consist of the family and the version. Versions of different
+	// families are not comparable.
+	HPE Scheme = hpeScheme{}
+)
+
+// ForManufacturer returns the versioning scheme of the manufacturer, or the
+// Generic one if manufacturer is not known.
+func ForManufacturer(manufacturer string) Scheme {
+	m := strings.ToLower(strings.TrimSpace(manufacturer))
+	switch {
+	case strings.HasPrefix(m, "lenovo"):
+		return Lenovo
+	case strings.HasPrefix(m, "dell"):
+		return Dell
+	case m == "hp" || strings.HasPrefix(m, "hpe") || strings.HasPrefix(m, "hewlett"):
+		return HPE
+	}
+	return Generic
+}
+
+// Sort sorts versions in ascending order according to the scheme. Versions
+// are grouped by comparability, version joins the first group it is
+// comparable with every version of, so the order within the group does not
+// depend on the order of comparisons. Groups keep the order of their first
+// appearance. Invalid versions, which are not comparable even with
+// themselves, follow all groups and are sorted as strings.
+func Sort(scheme Scheme, versions []string) {
+	var (
+		groups       [][]string
+		incomparable []string
+	)
+	for _, v := range versions {
+		if _, err := scheme.Compare(v, v); err != nil {
+			incomparable = append(incomparable, v)
+			continue
+		}
+		idx := slices.IndexFunc(groups, func(group []string) bool {
+			return !slices.ContainsFunc(group, func(other string) bool {
+				_, err := scheme.Compare(v, other)
+				return err != nil
+			})
+		})
+		if idx < 0 {
+			groups = append(groups, []string{v})
+			continue
+		}
+		groups[idx] = append(groups[idx], v)
+	}
+	// versions of the group are comparable, strings are compared just to keep
+	// the comparison total
+	compare := func(a, b string) int {
+		c, err := scheme.Compare(a, b)
+		if err != nil {
+			return strings.Compare(a, b)
+		}
+		return c
+	}
+	i := 0
+	for _, group := range groups {
+		slices.SortStableFunc(group, compare)
+		i += copy(versions[i:], group)
+	}
+	slices.Sort(incomparable)
+	copy(versions[i:], incomparable)
+}
+
+type genericScheme struct{}
+
+func (genericScheme) Compare(a, b string) (int, error) {
+	va, errA := parseVersion(a)
+	vb, errB := parseVersion(b)
+	if errA == nil && errB == nil {
+		return va.compare(vb), nil
+	}
+	ta, err := tokenize(a)
+	if err != nil {
+		return 0, err
+	}
+	tb, err := tokenize(b)
+	if err != nil {
+		return 0, err
+	}
+	return compareTokens(ta, tb)
+}
+
+// token is either the numeric or the alphabetic part of the version.
+type token struct {
+	text    string
+	numeric bool
+}
+
+// tokenize splits the version into numeric and alphabetic tokens, other
+// characters are considered separators. Numeric tokens are kept without
+// leading zeros, alphabetic ones are lowercased. Version must contain at
+// least one numeric token.
+func tokenize(in string) ([]token, error) {
+	var (
+		tokens  []token
+		current *token
+	)
+	for _, r := range strings.ToLower(in) {
+		numeric := unicode.IsDigit(r)
+		if !numeric && !unicode.IsLetter(r) {
+			current = nil
+			continue
+		}
+		if current == nil || current.numeric != numeric {
+			tokens = append(tokens, token{numeric: numeric})
+			current = &tokens[len(tokens)-1]
+		}
+		current.text += string(r)
+	}
+	if !slices.ContainsFunc(tokens, func(t token) bool { return t.numeric }) {
+		return nil, fmt.Errorf("invalid version %q", in)
+	}
+	for i := range tokens {
+		if tokens[i].numeric {
+			tokens[i].text = strings.TrimLeft(tokens[i].text, "0")
+		}
+	}
+	return tokens, nil
+}
+
+// compareTokens compares tokens pairwise. Numeric tokens are compared by
+// value, alphabetic ones lexicographically, numeric and alphabetic tokens at
+// the same position are not comparable. Trailing zero tokens are ignored,
+// otherwise the longer version is considered greater.
+func compareTokens(a, b []token) (int, error) {
+	for i := 0; i < min(len(a), len(b)); i++ {
+		if a[i].numeric != b[i].numeric {
+			return 0, ErrNotComparable
+		}
+		if a[i].numeric {
+			if c := compareNumeric(a[i].text, b[i].text); c != 0 {
+				return c, nil
+			}
+			continue
+		}
+		if c := strings.Compare(a[i].text, b[i].text); c != 0 {
+			return c, nil
+		}
+	}
+	isZero := func(t token) bool { return t.numeric && t.text == "" }
+	switch {
+	case len(a) > len(b) && !allTokens(a[len(b):], isZero):
+		return 1, nil
+	case len(b) > len(a) && !allTokens(b[len(a):], isZero):
+		return -1, nil
+	}
+	return 0, nil
+}
+
+func allTokens(tokens []token, f func(token) bool) bool {
+	return !slices.ContainsFunc(tokens, func(t token) bool { return !f(t) })
+}
+
+// compareNumeric compares decimal numbers without leading zeros of any
+// length.
+func compareNumeric(a, b string) int {
+	if c := compareUint(uint64(len(a)), uint64(len(b))); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+var lenovoBuildID = regexp.MustCompile(`^([A-Za-z]{3,4})(\d{2,4})([A-Za-z])(?:-(v?\d+(?:\.\d+)*))?$`)
+
+type lenovoBuild struct {
+	prefix   string
+	number   uint64
+	revision string
+	version  string
+}
+
+func parseLenovoBuild(in string) (lenovoBuild, bool) {
+	m := lenovoBuildID.FindStringSubmatch(strings.TrimSpace(in))
+	if m == nil {
+		return lenovoBuild{}, false
+	}
+	number, err := strconv.ParseUint(m[2], 10, 64)
+	if err != nil {
+		return lenovoBuild{}, false
+	}
+	return lenovoBuild{
+		prefix:   strings.ToUpper(m[1]),
+		number:   number,
+		revision: strings.ToUpper(m[3]),
+		version:  m[4],
+	}, true
+}
+
+type lenovoScheme struct{}
+
+func (lenovoScheme) Compare(a, b string) (int, error) {
+	ba, okA := parseLenovoBuild(a)
+	bb, okB := parseLenovoBuild(b)
+	switch {
+	case okA && okB:
+		if ba.prefix != bb.prefix {
+			return 0, ErrNotComparable
+		}
+		if c := compareUint(ba.number, bb.number); c != 0 {
+			return c, nil
+		}
+		return strings.Compare(ba.revision, bb.revision), nil
+	case okA:
+		// build ID followed by the version is comparable with plain versions
+		if ba.version == "" {
+			return 0, ErrNotComparable
+		}
+		return Generic.Compare(ba.version, b)
+	case okB:
+		if bb.version == "" {
+			return 0, ErrNotComparable
+		}
+		return Generic.Compare(a, bb.version)
+	}
+	return Generic.Compare(a, b)
+}
+
+var dellRevision = regexp.MustCompile(`^([AaXx])(\d+)$`)
+
+type dellScheme struct{}
+
+func (dellScheme) Compare(a, b string) (int, error) {
+	ma := dellRevision.FindStringSubmatch(strings.TrimSpace(a))
+	mb := dellRevision.FindStringSubmatch(strings.TrimSpace(b))
+	switch {
+	case ma != nil && mb != nil:
+		// X-revisions are pre-releases of A-revisions, so "X" < "A"
+		ka, kb := strings.ToUpper(ma[1]), strings.ToUpper(mb[1])
+		if ka != kb {
+			return -strings.Compare(ka, kb), nil
+		}
+		return compareNumeric(strings.TrimLeft(ma[2], "0"), strings.TrimLeft(mb[2], "0")), nil
+	case ma != nil || mb != nil:
+		return 0, ErrNotComparable
+	}
+	ta, err := tokenize(a)
+	if err != nil {
+		return 0, err
+	}
+	tb, err := tokenize(b)
+	if err != nil {
+		return 0, err
+	}
+	return compareTokens(ta, tb)
+}
+
+var (
+	hpeSuffix  = regexp.MustCompile(`\s*(\([^)]*\)|[A-Z][a-z]{2} \d{1,2} \d{4})$`)
+	hpeVersion = regexp.MustCompile(`^(?:(.*\S)\s+)?[vV]?(\d+(?:\.\d+)*)$`)
+)
+
+type hpeScheme struct{}
+
+// parseHPE splits the version into the family and the dotted numeric
+// version, release date suffix is dropped.
+func parseHPE(in string) (string, string, bool) {
+	s := hpeSuffix.ReplaceAllString(strings.TrimSpace(in), "")
+	m := hpeVersion.FindStringSubmatch(s)
+	if m == nil {
+		return "", "", false
+	}
+	return strings.ToUpper(strings.Join(strings.Fields(m[1]), " ")), m[2], true
+}
+
+func (hpeScheme) Compare(a, b string) (int, error) {
+	fa, va, okA := parseHPE(a)
+	fb, vb, okB := parseHPE(b)
+	if !okA || !okB {
+		return Generic.Compare(a, b)
+	}
+	// version without the family is comparable with any family
+	if fa != "" && fb != "" && fa != fb {
+		return 0, ErrNotComparable
+	}
+	return Generic.Compare(va, vb)
+}
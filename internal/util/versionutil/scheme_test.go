@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package versionutil
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Versioning schemes", func() {
+	DescribeTable("Selecting scheme by manufacturer",
+		func(manufacturer string, expected Scheme) {
+			Expect(ForManufacturer(manufacturer)).To(Equal(expected))
+		},
+		Entry("Lenovo", "Lenovo", Lenovo),
+		Entry("Dell", "Dell Inc.", Dell),
+		Entry("HPE", "HPE", HPE),
+		Entry("Hewlett Packard Enterprise", "Hewlett Packard Enterprise", HPE),
+		Entry("unknown manufacturer", "Supermicro", Generic),
+		Entry("empty manufacturer", "", Generic),
+	)
+
+	DescribeTable("Comparing versions",
+		func(scheme Scheme, a, b string, expected int) {
+			c, err := scheme.Compare(a, b)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c).To(Equal(expected))
+			c, err = scheme.Compare(b, a)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c).To(Equal(-expected))
+		},
+		Entry("generic: numeric components", Generic, "1.2.10", "1.2.9", 1),
+		Entry("generic: leading v", Generic, "v1.0", "1.0.0", 0),
+		Entry("generic: pre-release", Generic, "1.0.0-rc.1", "1.0.0", -1),
+		Entry("generic: alphabetic prefix", Generic, "FW1.10", "FW1.2", 1),
+		Entry("generic: leading zeros", Generic, "BIOS 02.10", "BIOS 2.9", 1),
+		Entry("generic: huge build numbers", Generic, "r20240101120000", "r20231231235959", 1),
+
+		Entry("lenovo: build number", Lenovo, "TEE156K", "TEE180J", -1),
+		Entry("lenovo: revision letter", Lenovo, "TEE156K", "TEE156J", 1),
+		Entry("lenovo: same build", Lenovo, "TEE156K", "tee156k", 0),
+		Entry("lenovo: four letter prefix", Lenovo, "TGBT56O", "TGBT62P", -1),
+		Entry("lenovo: build ID with version", Lenovo, "TEE156K-2.91", "TEE180J-3.20", -1),
+		Entry("lenovo: build ID with version and plain version", Lenovo, "TEE156K-2.91", "2.80", 1),
+		Entry("lenovo: plain versions", Lenovo, "3.20", "2.91", 1),
+
+		Entry("dell: BIOS", Dell, "2.19.1", "2.9.0", 1),
+		Entry("dell: iDRAC", Dell, "7.00.00.171", "6.10.80.00", 1),
+		Entry("dell: zero padded components", Dell, "4.40.00.00", "4.40.10.00", -1),
+		Entry("dell: trailing zeros", Dell, "22.31.6", "22.31.6.00", 0),
+		Entry("dell: build number suffix", Dell, "51.16.0-4076", "51.16.0", 1),
+		Entry("dell: build numbers", Dell, "51.16.0-4076", "51.16.0-5150", -1),
+		Entry("dell: legacy revisions", Dell, "A07", "A12", -1),
+		Entry("dell: X-revision precedes A-revision", Dell, "X05", "A00", -1),
+
+		Entry("hpe: system ROM", HPE, "U30 v2.80 (10/16/2023)", "U30 v2.76 (02/09/2023)", 1),
+		Entry("hpe: release date is ignored", HPE, "U30 v2.80 (10/16/2023)", "U30 v2.80", 0),
+		Entry("hpe: iLO", HPE, "iLO 5 v2.78", "iLO 5 v3.01", -1),
+		Entry("hpe: family is case insensitive", HPE, "ILO 5 v2.78", "iLO 5 v2.78", 0),
+		Entry("hpe: iLO with build date", HPE, "2.78 Jan 11 2023", "2.72 Sep 04 2022", 1),
+		Entry("hpe: version without family", HPE, "U30 v2.80", "2.76", 1),
+		Entry("hpe: two digit minor", HPE, "U30 v2.80", "U30 v2.9", 1),
+		Entry("hpe: SPP", HPE, "2023.09.00.00", "2023.03.00.00", 1),
+	)
+
+	DescribeTable("Comparing versions which are not comparable",
+		func(scheme Scheme, a, b string) {
+			_, err := scheme.Compare(a, b)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("generic: numeric and alphabetic components", Generic, "1.0", "abc1"),
+		Entry("generic: no numeric components", Generic, "1.0", "latest"),
+		Entry("lenovo: different products", Lenovo, "TEE156K", "TEI392P"),
+		Entry("lenovo: build ID without version and plain version", Lenovo, "TEE156K", "2.91"),
+		Entry("dell: legacy revision and dotted version", Dell, "A07", "2.19.1"),
+		Entry("hpe: different ROM families", HPE, "U30 v2.80", "U32 v2.80"),
+		Entry("hpe: different iLO generations", HPE, "iLO 5 v2.78", "iLO 6 v1.55"),
+	)
+
+	DescribeTable("Sorting versions",
+		func(scheme Scheme, versions, expected []string) {
+			Sort(scheme, versions)
+			Expect(versions).To(Equal(expected))
+		},
+		Entry("lenovo: grouped by product", Lenovo,
+			[]string{"TEE180J", "TEI392P", "TEE156K", "TEI380A"},
+			[]string{"TEE156K", "TEE180J", "TEI380A", "TEI392P"}),
+		Entry("dell: legacy revisions follow dotted versions", Dell,
+			[]string{"2.19.1", "A07", "2.9.0", "X05", "A10"},
+			[]string{"2.9.0", "2.19.1", "X05", "A07", "A10"}),
+		Entry("hpe: system ROMs", HPE,
+			[]string{"U30 v2.80 (10/16/2023)", "U30 v1.50 (06/22/2021)", "U30 v2.76 (02/09/2023)"},
+			[]string{"U30 v1.50 (06/22/2021)", "U30 v2.76 (02/09/2023)", "U30 v2.80 (10/16/2023)"}),
+		Entry("generic: numeric components", Generic,
+			[]string{"1.10.0", "1.2.0", "1.9.3"},
+			[]string{"1.2.0", "1.9.3", "1.10.0"}),
+		Entry("generic: invalid versions follow valid ones", Generic,
+			[]string{"beta", "1.10", "alpha", "1.2"},
+			[]string{"1.2", "1.10", "alpha", "beta"}),
+		Entry("lenovo: builds of different products comparable with the same version", Lenovo,
+			[]string{"2.0", "TEE160K-1.5", "TEI380A-1.0", "beta"},
+			[]string{"TEE160K-1.5", "2.0", "TEI380A-1.0", "beta"}),
+	)
+
+	DescribeTable("Resolving constraints with vendor versions",
+		func(scheme Scheme, constraint string, available []string, expected string) {
+			c, err := ParseConstraint(constraint)
+			Expect(err).NotTo(HaveOccurred())
+			resolved, ok := c.Resolve(scheme, available)
+			Expect(ok).To(BeTrue())
+			Expect(resolved).To(Equal(expected))
+		},
+		Entry("lenovo: latest build", Lenovo, "latest",
+			[]string{"TEE156K", "TEE180J", "TEE162H"}, "TEE180J"),
+		Entry("lenovo: builds below", Lenovo, "<TEE170A",
+			[]string{"TEE156K", "TEE180J", "TEE162H"}, "TEE162H"),
+		Entry("dell: tilde", Dell, "~2.19",
+			[]string{"2.9.0", "2.19.1", "2.19.4", "2.20.0"}, "2.19.4"),
+		Entry("dell: build numbers", Dell, ">=51.16.0",
+			[]string{"51.16.0-4076", "51.15.0-4296", "51.16.0-5150"}, "51.16.0-5150"),
+		Entry("hpe: version without family", HPE, "<2.80",
+			[]string{"U30 v2.68 (07/14/2022)", "U30 v2.76 (02/09/2023)", "U30 v2.80 (10/16/2023)"},
+			"U30 v2.76 (02/09/2023)"),
+		Entry("generic: latest skips invalid versions", Generic, "latest",
+			[]string{"1.2", "1.10", "beta"}, "1.10"),
+	)
+})
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package versionutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// version is the parsed dotted numeric version with optional pre-release
+// suffix, e.g. 2.8.1 or v2.8.1-rc.1.
+type version struct {
+	numbers    []uint64
+	prerelease []string
+}
+
+func parseVersion(in string) (version, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(in), "v")
+	core, prerelease, _ := strings.Cut(s, "-")
+	if core == "" {
+		return version{}, fmt.Errorf("invalid version %q", in)
+	}
+	var v version
+	for _, part := range strings.Split(core, ".") {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return version{}, fmt.Errorf("invalid version %q", in)
+		}
+		v.numbers = append(v.numbers, n)
+	}
+	if prerelease != "" {
+		v.prerelease = strings.Split(prerelease, ".")
+	}
+	return v, nil
+}
+
+func (v version) number(idx int) uint64 {
+	if idx < len(v.numbers) {
+		return v.numbers[idx]
+	}
+	return 0
+}
+
+// compare returns -1, 0 or 1 if v is lower, equal or greater than other.
+// Missing components are considered zero, version with pre-release suffix
+// is lower than the same version without it.
+func (v version) compare(other version) int {
+	for i := 0; i < max(len(v.numbers), len(other.numbers)); i++ {
+		if c := compareUint(v.number(i), other.number(i)); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < min(len(v.prerelease), len(other.prerelease)); i++ {
+		if c := compareIdentifier(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.prerelease)), uint64(len(other.prerelease)))
+}
+
+// bump returns the version with component at idx incremented and following
+// components dropped.
+func (v version) bump(idx int) version {
+	numbers := make([]uint64, idx+1)
+	for i := range numbers {
+		numbers[i] = v.number(i)
+	}
+	numbers[idx]++
+	return version{numbers: numbers}
+}
+
+// String returns the version in its canonical form.
+func (v version) String() string {
+	parts := make([]string, len(v.numbers))
+	for i, n := range v.numbers {
+		parts[i] = strconv.FormatUint(n, 10)
+	}
+	s := strings.Join(parts, ".")
+	if len(v.prerelease) > 0 {
+		s += "-" + strings.Join(v.prerelease, ".")
+	}
+	return s
+}
+
+func compareIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package windowutil
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// Friday
+	now := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+
+	DescribeTable("Should find next activation",
+		func(schedule string, expected time.Time) {
+			s, err := ParseSchedule(schedule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Next(now)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2024, time.March, 1, 12, 31, 0, 0, time.UTC)),
+		Entry("steps", "*/15 * * * *", time.Date(2024, time.March, 1, 12, 45, 0, 0, time.UTC)),
+		Entry("same day", "0 22 * * *", time.Date(2024, time.March, 1, 22, 0, 0, 0, time.UTC)),
+		Entry("next day", "0 10 * * *", time.Date(2024, time.March, 2, 10, 0, 0, 0, time.UTC)),
+		Entry("weekdays", "0 10 * * 1-5", time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 0 * * 7", time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)),
+		Entry("list", "0 9,13 * * *", time.Date(2024, time.March, 1, 13, 0, 0, 0, time.UTC)),
+		Entry("either day field", "0 0 15 * 6", time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC)),
+		Entry("leap day", "0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("never", "0 0 30 2 *", time.Time{}),
+	)
+
+	DescribeTable("Should reject invalid schedule",
+		func(schedule string) {
+			_, err := ParseSchedule(schedule)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("missing field", "0 22 * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("inverted range", "0 5-1 * * *"),
+		Entry("invalid step", "*/0 * * * *"),
+		Entry("not a number", "0 22 * * mon"),
+	)
+})
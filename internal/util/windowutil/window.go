@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package windowutil
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	// time zone database is embedded, since images might not provide it
+	_ "time/tzdata"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// State is the state of maintenance windows applying to the machine.
+type State struct {
+	// Open reports whether disruptive work is allowed now. Machines without
+	// maintenance windows are always open.
+	Open bool
+	// ClosesAt is the time the open window closes, zero if no window applies.
+	ClosesAt time.Time
+	// OpensAt is the time the next window opens, zero if the state is open
+	// or windows never open.
+	OpensAt time.Time
+}
+
+// ForMachine returns maintenance windows of the machine type applying to the
+// machine: windows referred by machine group of the machine and windows which
+// selectors match machine labels.
+func ForMachine(
+	machine *lifecyclev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+	group *lifecyclev1alpha1.MachineGroup,
+) ([]lifecyclev1alpha1.MaintenanceWindow, error) {
+	var result []lifecyclev1alpha1.MaintenanceWindow
+	if group != nil {
+		for _, name := range group.MaintenanceWindows {
+			idx := index(machineType.Spec.MaintenanceWindows, name)
+			if idx < 0 {
+				return nil, fmt.Errorf("machine group %s refers to unknown maintenance window %s", group.Name, name)
+			}
+			result = append(result, machineType.Spec.MaintenanceWindows[idx])
+		}
+	}
+	for _, window := range machineType.Spec.MaintenanceWindows {
+		if window.MachineSelector == nil || index(result, window.Name) >= 0 {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(window.MachineSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of maintenance window %s: %w", window.Name, err)
+		}
+		if selector.Matches(labels.Set(machine.Labels)) {
+			result = append(result, window)
+		}
+	}
+	return result, nil
+}
+
+// Evaluate returns the state of maintenance windows at the given time. The
+// state is open if any of windows is open.
+func Evaluate(windows []lifecyclev1alpha1.MaintenanceWindow, now time.Time) (State, error) {
+	if len(windows) == 0 {
+		return State{Open: true}, nil
+	}
+	state := State{}
+	for _, window := range windows {
+		start, err := lastStart(window, now)
+		if err != nil {
+			return State{}, err
+		}
+		closesAt := start.Add(window.Duration.Duration)
+		switch {
+		case start.IsZero():
+			continue
+		case !start.After(now):
+			state.Open = true
+			if closesAt.After(state.ClosesAt) {
+				state.ClosesAt = closesAt
+			}
+		case state.OpensAt.IsZero() || start.Before(state.OpensAt):
+			state.OpensAt = start
+		}
+	}
+	if state.Open {
+		state.OpensAt = time.Time{}
+	}
+	return state, nil
+}
+
+// Check returns the state of maintenance windows applying to the machine at
+// the given time.
+func Check(
+	machine *lifecyclev1alpha1.Machine,
+	machineType *lifecyclev1alpha1.MachineType,
+	group *lifecyclev1alpha1.MachineGroup,
+	now time.Time,
+) (State, error) {
+	windows, err := ForMachine(machine, machineType, group)
+	if err != nil {
+		return State{}, err
+	}
+	return Evaluate(windows, now)
+}
+
+// lastStart returns the start of the window being open at the given time,
+// or the start of the next window if it is closed.
+func lastStart(window lifecyclev1alpha1.MaintenanceWindow, now time.Time) (time.Time, error) {
+	schedule, err := ParseSchedule(window.Schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("maintenance window %s: %w", window.Name, err)
+	}
+	if window.Duration.Duration <= 0 {
+		return time.Time{}, fmt.Errorf("maintenance window %s: duration must be positive", window.Name)
+	}
+	location, err := time.LoadLocation(window.TimeZone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("maintenance window %s: %w", window.Name, err)
+	}
+	return schedule.Next(now.In(location).Add(-window.Duration.Duration)), nil
+}
+
+func index(windows []lifecyclev1alpha1.MaintenanceWindow, name string) int {
+	return slices.IndexFunc(windows, func(w lifecyclev1alpha1.MaintenanceWindow) bool {
+		return w.Name == name
+	})
+}
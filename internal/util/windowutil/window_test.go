@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package windowutil
+
+import (
+	"time"
+
+	lifecyclev1alpha1 "github.com/ironcore-dev/lifecycle-manager/api/lifecycle/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Window", func() {
+	// Friday
+	now := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+	nightly := lifecyclev1alpha1.MaintenanceWindow{
+		Name:     "nightly",
+		Schedule: "0 22 * * *",
+		Duration: metav1.Duration{Duration: 4 * time.Hour},
+	}
+	lunch := lifecyclev1alpha1.MaintenanceWindow{
+		Name:     "lunch",
+		Schedule: "0 12 * * 1-5",
+		Duration: metav1.Duration{Duration: time.Hour},
+		MachineSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"rack": "a"},
+		},
+	}
+	machineType := &lifecyclev1alpha1.MachineType{
+		Spec: lifecyclev1alpha1.MachineTypeSpec{
+			MaintenanceWindows: []lifecyclev1alpha1.MaintenanceWindow{nightly, lunch},
+		},
+	}
+
+	It("Should be open without windows", func() {
+		state, err := Evaluate(nil, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state).To(Equal(State{Open: true}))
+	})
+
+	It("Should be open within the window", func() {
+		state, err := Evaluate([]lifecyclev1alpha1.MaintenanceWindow{lunch}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Open).To(BeTrue())
+		Expect(state.ClosesAt).To(BeTemporally("==", time.Date(2024, time.March, 1, 13, 0, 0, 0, time.UTC)))
+	})
+
+	It("Should be closed outside the window", func() {
+		state, err := Evaluate([]lifecyclev1alpha1.MaintenanceWindow{nightly}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Open).To(BeFalse())
+		Expect(state.OpensAt).To(BeTemporally("==", time.Date(2024, time.March, 1, 22, 0, 0, 0, time.UTC)))
+	})
+
+	It("Should be open within window started the day before", func() {
+		state, err := Evaluate([]lifecyclev1alpha1.MaintenanceWindow{nightly}, now.Add(13*time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Open).To(BeTrue())
+		Expect(state.ClosesAt).To(BeTemporally("==", time.Date(2024, time.March, 2, 2, 0, 0, 0, time.UTC)))
+	})
+
+	It("Should respect time zone", func() {
+		window := nightly
+		window.TimeZone = "Asia/Tokyo"
+		// 12:30 UTC is 21:30 in Tokyo
+		state, err := Evaluate([]lifecyclev1alpha1.MaintenanceWindow{window}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Open).To(BeFalse())
+		Expect(state.OpensAt).To(BeTemporally("==", time.Date(2024, time.March, 1, 13, 0, 0, 0, time.UTC)))
+	})
+
+	It("Should fail on invalid window", func() {
+		window := nightly
+		window.TimeZone = "Unknown/Zone"
+		_, err := Evaluate([]lifecyclev1alpha1.MaintenanceWindow{window}, now)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should select windows of machine group and matching selectors", func() {
+		machine := &lifecyclev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"rack": "a"}},
+		}
+		group := &lifecyclev1alpha1.MachineGroup{Name: "production", MaintenanceWindows: []string{"nightly"}}
+		windows, err := ForMachine(machine, machineType, group)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(windows).To(Equal([]lifecyclev1alpha1.MaintenanceWindow{nightly, lunch}))
+
+		machine.Labels = nil
+		windows, err = ForMachine(machine, machineType, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(windows).To(BeEmpty())
+	})
+
+	It("Should fail on unknown window of machine group", func() {
+		group := &lifecyclev1alpha1.MachineGroup{Name: "production", MaintenanceWindows: []string{"weekly"}}
+		_, err := ForMachine(&lifecyclev1alpha1.Machine{}, machineType, group)
+		Expect(err).To(HaveOccurred())
+	})
+})